@@ -0,0 +1,260 @@
+package gofig
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultSeparator = ","
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// isScalar reports whether a value of the given type can be decoded from a single string by setValue
+func isScalar(t reflect.Type) bool {
+	if t == durationType || t == timeType {
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// isDecodable reports whether a value of the given type can be decoded by decodeValue.
+// These are scalars, slices of scalars and maps with scalar keys and values
+func isDecodable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Slice:
+		return isScalar(t.Elem())
+	case reflect.Map:
+		return isScalar(t.Key()) && isScalar(t.Elem())
+	default:
+		return isScalar(t)
+	}
+}
+
+// decodeValue decodes a resolved value into the given field. The value is either a string
+// or a native value returned by a RawProvider (e.g. a JSON array or object)
+func decodeValue(fieldValue reflect.Value, field reflect.StructField, value interface{}) error {
+	switch fieldValue.Kind() {
+	case reflect.Slice:
+		return decodeSlice(fieldValue, field, value)
+	case reflect.Map:
+		return decodeMap(fieldValue, field, value)
+	}
+
+	return setScalar(fieldValue, field, value)
+}
+
+// setScalar sets a native scalar value to the given field, converting it to its string form and decoding it
+// unless it already has the type of the field (e.g. a time.Time parsed by the provider)
+func setScalar(fieldValue reflect.Value, field reflect.StructField, value interface{}) error {
+	if timeValue, ok := value.(time.Time); ok && fieldValue.Type() == timeType {
+		fieldValue.Set(reflect.ValueOf(timeValue))
+		return nil
+	}
+
+	str, err := stringify(value)
+	if err != nil {
+		return err
+	}
+
+	return setValue(fieldValue, field, str)
+}
+
+// decodeSlice decodes either a native list or a string split on the sep tag of the field (default ",")
+func decodeSlice(fieldValue reflect.Value, field reflect.StructField, value interface{}) error {
+	var items []interface{}
+
+	switch typed := value.(type) {
+	case []interface{}:
+		items = typed
+	case string:
+		for _, item := range splitList(typed, separator(field)) {
+			items = append(items, item)
+		}
+	default:
+		return fmt.Errorf("cannot decode %T into %s", value, fieldValue.Type())
+	}
+
+	slice := reflect.MakeSlice(fieldValue.Type(), len(items), len(items))
+
+	for i, item := range items {
+		err := setScalar(slice.Index(i), field, item)
+		if err != nil {
+			return fmt.Errorf("item %d: %w", i, err)
+		}
+	}
+
+	fieldValue.Set(slice)
+	return nil
+}
+
+// decodeMap decodes either a native object or a string of key=value pairs split on the sep tag
+// of the field (default ",")
+func decodeMap(fieldValue reflect.Value, field reflect.StructField, value interface{}) error {
+	entries := map[string]interface{}{}
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		entries = typed
+	case string:
+		for _, item := range splitList(typed, separator(field)) {
+			key, val, found := strings.Cut(item, "=")
+			if !found {
+				return fmt.Errorf("invalid map entry %q, expected key=value", item)
+			}
+
+			entries[strings.TrimSpace(key)] = strings.TrimSpace(val)
+		}
+	default:
+		return fmt.Errorf("cannot decode %T into %s", value, fieldValue.Type())
+	}
+
+	mapType := fieldValue.Type()
+	result := reflect.MakeMapWithSize(mapType, len(entries))
+
+	for key, item := range entries {
+		mapKey := reflect.New(mapType.Key()).Elem()
+		err := setValue(mapKey, field, key)
+		if err != nil {
+			return fmt.Errorf("key %q: %w", key, err)
+		}
+
+		mapValue := reflect.New(mapType.Elem()).Elem()
+		err = setScalar(mapValue, field, item)
+		if err != nil {
+			return fmt.Errorf("key %q: %w", key, err)
+		}
+
+		result.SetMapIndex(mapKey, mapValue)
+	}
+
+	fieldValue.Set(result)
+	return nil
+}
+
+// stringify converts a native scalar value to its string form so that it can be decoded by setValue
+func stringify(value interface{}) (string, error) {
+	switch typed := value.(type) {
+	case string:
+		return typed, nil
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64), nil
+	case float32:
+		return strconv.FormatFloat(float64(typed), 'f', -1, 32), nil
+	case time.Time:
+		return typed.Format(time.RFC3339Nano), nil
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(typed), nil
+	default:
+		return "", fmt.Errorf("cannot decode %T as a scalar value", value)
+	}
+}
+
+// separator returns the list separator of a field from its sep tag
+func separator(field reflect.StructField) string {
+	sep, ok := field.Tag.Lookup("sep")
+	if !ok || sep == "" {
+		return defaultSeparator
+	}
+
+	return sep
+}
+
+// splitList splits a string list on the given separator trimming the whitespace around the items
+func splitList(value string, sep string) []string {
+	if strings.TrimSpace(value) == "" {
+		return []string{}
+	}
+
+	parts := strings.Split(value, sep)
+	for i, part := range parts {
+		parts[i] = strings.TrimSpace(part)
+	}
+
+	return parts
+}
+
+// setValue decodes the resolved string value into the given field according to the field's type.
+// Time fields are parsed using the layout tag of the field (time.RFC3339 if not set).
+// An empty value sets the field to its zero value
+func setValue(fieldValue reflect.Value, field reflect.StructField, value string) error {
+	if value == "" {
+		fieldValue.Set(reflect.Zero(fieldValue.Type()))
+		return nil
+	}
+
+	switch fieldValue.Type() {
+	case durationType:
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+
+		fieldValue.SetInt(int64(duration))
+		return nil
+	case timeType:
+		layout := field.Tag.Get("layout")
+		if layout == "" {
+			layout = time.RFC3339
+		}
+
+		parsed, err := time.Parse(layout, value)
+		if err != nil {
+			return err
+		}
+
+		fieldValue.Set(reflect.ValueOf(parsed))
+		return nil
+	}
+
+	switch fieldValue.Kind() {
+	case reflect.String:
+		fieldValue.SetString(value)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+
+		fieldValue.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(value, 10, fieldValue.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		fieldValue.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(value, 10, fieldValue.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		fieldValue.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(value, fieldValue.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		fieldValue.SetFloat(parsed)
+	default:
+		return ErrUnsupportedFieldType
+	}
+
+	return nil
+}
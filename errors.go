@@ -0,0 +1,76 @@
+package gofig
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrUnsupportedFieldType  = errors.New("unsupported field type")
+	ErrInvalidFieldValue     = errors.New("invalid field value")
+	ErrRequiredField         = errors.New("required field has no value")
+	ErrValidation            = errors.New("validation failed")
+	ErrInvalidValidationRule = errors.New("invalid validation rule")
+	ErrUnknownSource         = errors.New("no provider registered with the source name")
+)
+
+// FieldError describes why a single field of the config could not be populated
+type FieldError struct {
+	// The full prop path of the field (e.g. postgres.host)
+	Path string
+
+	// The Go name of the field including its parent structs (e.g. Postgres.Host)
+	Field string
+
+	// The provider that failed to resolve the value, empty if the error is not caused by a provider
+	Provider string
+
+	// The underlying error
+	Err error
+}
+
+func newFieldError(current Field, provider string, err error) *FieldError {
+	return &FieldError{
+		Path:     strings.Join(current.fullPath, "."),
+		Field:    strings.Join(current.goPath, "."),
+		Provider: provider,
+		Err:      err,
+	}
+}
+
+func (fe *FieldError) Error() string {
+	if fe.Provider != "" {
+		return fmt.Sprintf("field %s (%s) from provider %s: %v", fe.Field, fe.Path, fe.Provider, fe.Err)
+	}
+
+	return fmt.Sprintf("field %s (%s): %v", fe.Field, fe.Path, fe.Err)
+}
+
+func (fe *FieldError) Unwrap() error {
+	return fe.Err
+}
+
+// PopulateError is returned by PopulateConfig and lists every field that could not be populated.
+// It supports errors.Is and errors.As for both the field errors and their underlying errors
+type PopulateError struct {
+	Errors []*FieldError
+}
+
+func (pe *PopulateError) Error() string {
+	messages := make([]string, len(pe.Errors))
+	for i, err := range pe.Errors {
+		messages[i] = err.Error()
+	}
+
+	return fmt.Sprintf("failed to populate config: %s", strings.Join(messages, "; "))
+}
+
+func (pe *PopulateError) Unwrap() []error {
+	errs := make([]error, len(pe.Errors))
+	for i, err := range pe.Errors {
+		errs[i] = err
+	}
+
+	return errs
+}
@@ -1,33 +1,72 @@
 package gofig
 
 import (
-	"errors"
+	"context"
+	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/darklam/gofig/interfaces"
 )
 
 // Gofig is the struct containing the registered providers and responsible for populating the provided configuration
 type Gofig struct {
-	providers []interfaces.Provider
+	providers []registeredProvider
 }
 
 // NewGofig returns a new Gofig instance without any provider
 func NewGofig() *Gofig {
-	return &Gofig{providers: make([]interfaces.Provider, 0)}
+	return &Gofig{providers: make([]registeredProvider, 0)}
 }
 
-// RegisterProvider registers a new provider for Gofig to use. If the provider's name collides with another
-// provider, then only the one registered last will be used
+// RegisterProvider registers a new provider for Gofig to use without a name.
+// Unnamed providers never collide with other providers, but they cannot be selected with the source tag
 func (gofig *Gofig) RegisterProvider(provider interfaces.Provider) {
-	gofig.providers = append(gofig.providers, provider)
+	gofig.providers = append(gofig.providers, registeredProvider{provider: provider})
+}
+
+// RegisterNamedProvider registers a new provider for Gofig to use under the given name, which can be used
+// in the source tag of fields to select it. If the provider's name collides with another
+// provider, then only the one registered last will be used
+func (gofig *Gofig) RegisterNamedProvider(name string, provider interfaces.Provider) {
+	providers := make([]registeredProvider, 0, len(gofig.providers)+1)
+	for _, registered := range gofig.providers {
+		if registered.name != name {
+			providers = append(providers, registered)
+		}
+	}
+
+	gofig.providers = append(providers, registeredProvider{name: name, provider: provider})
 }
 
 // PopulateConfig populates the values of the given config
 // The cfg parameter must be a pointer to a struct (not nil)
-// and the struct can only contain string values or pointers to other structs
-// (these can and should not be initialized)
+// and the struct can contain strings, bools, ints, uints, floats, time.Duration and time.Time values,
+// slices and maps of those, nested structs or pointers to other structs (these can and should not be initialized).
+// Embedded structs are flattened into the parent struct unless they have a prop tag.
+// After population, required fields without a value and fields violating their validate tag are reported
+// along with any other errors in a *PopulateError
 func (gofig *Gofig) PopulateConfig(cfg interface{}) error {
+	return gofig.PopulateConfigContext(context.Background(), cfg)
+}
+
+// PopulateConfigContext populates the values of the given config like PopulateConfig does, passing the context
+// to the providers that implement interfaces.ContextProvider. If the context is done before all fields
+// are populated, it stops and returns the error of the context
+func (gofig *Gofig) PopulateConfigContext(ctx context.Context, cfg interface{}) error {
+	_, err := gofig.populate(ctx, cfg)
+	return err
+}
+
+// PopulateConfigWithProvenance populates the values of the given config like PopulateConfig does
+// and also returns where the value of every populated field came from.
+// The provenance of the fields that were populated is returned even if other fields failed
+func (gofig *Gofig) PopulateConfigWithProvenance(cfg interface{}) (Provenance, error) {
+	return gofig.populate(context.Background(), cfg)
+}
+
+func (gofig *Gofig) populate(ctx context.Context, cfg interface{}) (Provenance, error) {
 	// Get the reflect.Type and reflect.Value of the provided configuration struct
 	t := reflect.TypeOf(cfg)
 	v := reflect.ValueOf(cfg)
@@ -41,14 +80,28 @@ func (gofig *Gofig) PopulateConfig(cfg interface{}) error {
 	// Get all the top-level fields of the provided configuration struct
 	fields := getFields(t, nil, v)
 
+	// Collect the errors of all fields, so they can be reported at once
+	fieldErrors := make([]*FieldError, 0)
+
+	// Keep the fields to validate after population, including the ones that kept their zero value (unresolved)
+	populated := make([]Field, 0)
+	unresolved := make([]Field, 0)
+
+	provenance := Provenance{}
+
 	// Iterate over the fields to populate their values
 	for len(fields) != 0 {
+		// Stop if the context is done, since the remaining values cannot be resolved in time
+		if err := ctx.Err(); err != nil {
+			return provenance, err
+		}
+
 		// Get the current field and remove it from the fields list
 		current := pop(&fields)
 		field := current.field
 
 		// Get the reflect.Value of the current field
-		fieldValue := current.parentValue.FieldByName(field.Name)
+		fieldValue := current.parentValue.FieldByIndex(field.Index)
 
 		// Check if the current field is a pointer to another struct
 		if fieldValue.Kind() == reflect.Ptr {
@@ -57,9 +110,10 @@ func (gofig *Gofig) PopulateConfig(cfg interface{}) error {
 			fieldPointerInterface := pointerInstance.Elem().Interface()
 			fieldPointerInterfaceType := reflect.TypeOf(fieldPointerInterface).Elem()
 
-			// Ensure the pointed type is a struct, otherwise return an error
+			// Ensure the pointed type is a struct, otherwise record an error
 			if fieldPointerInterfaceType.Kind() != reflect.Struct {
-				return errors.New("only struct pointers and strings are allowed")
+				fieldErrors = append(fieldErrors, newFieldError(current, "", ErrUnsupportedFieldType))
+				continue
 			}
 
 			// Instantiate the struct and assign it to the pointer field
@@ -71,30 +125,192 @@ func (gofig *Gofig) PopulateConfig(cfg interface{}) error {
 			fields = append(fields, currentFields...)
 
 			continue
-		} else if fieldValue.Kind() != reflect.String {
-			// Ensure the field is a string, otherwise return an error
-			return errors.New("only struct pointers and strings are allowed")
+		} else if fieldValue.Kind() == reflect.Struct && field.Type != timeType {
+			// Struct values are populated in place, so we only add their fields to the fields list
+			currentFields := getFields(field.Type, &current, fieldValue)
+			fields = append(fields, currentFields...)
+
+			continue
+		} else if !isDecodable(field.Type) {
+			// Ensure the field can be decoded from a string, otherwise record an error
+			fieldErrors = append(fieldErrors, newFieldError(current, "", ErrUnsupportedFieldType))
+			continue
 		}
 
 		// Get the default value for the field from its tag
-		value := field.Tag.Get("default")
+		var value interface{}
+		hasValue := false
+		sources := make([]Source, 0)
+		if defaultValue := field.Tag.Get("default"); defaultValue != "" {
+			value = defaultValue
+			hasValue = true
+			sources = append(sources, Source{Provider: DefaultSource, Value: defaultValue})
+		}
 
 		// Iterate over the registered providers to resolve the value for the current field
-		for _, provider := range gofig.providers {
-			resolved, err := provider.GetValue(current.fullPath)
+		providerFailed := false
+		allowedSources := fieldSources(field)
+
+		// Report the names of the source tag without a registered provider (e.g. typos), instead of silently
+		// leaving the field without a value
+		if unknown := gofig.unknownSources(allowedSources); len(unknown) != 0 {
+			err := fmt.Errorf("%w: %s", ErrUnknownSource, strings.Join(unknown, ", "))
+			fieldErrors = append(fieldErrors, newFieldError(current, "", err))
+			continue
+		}
+
+		for _, registered := range gofig.providers {
+			// Skip the providers that are not allowed by the source tag of the field
+			if allowedSources != nil && !allowedSources[registered.name] {
+				continue
+			}
+
+			resolved, found, err := getValue(ctx, registered.provider, current.fullPath)
 			if err != nil {
-				return err
+				fieldErrors = append(fieldErrors, newFieldError(current, registered.displayName(), err))
+				providerFailed = true
+				continue
 			}
-			if resolved == "" {
+			if !found {
 				continue
 			}
 
-			// Use the resolved value if it's not empty
+			// Use the resolved value if it was found
 			value = resolved
+			hasValue = true
+			sources = append(sources, Source{Provider: registered.displayName(), Value: resolved})
+		}
+
+		if providerFailed {
+			continue
+		}
+
+		// Fields keep their zero value when no value was found, unless they are required
+		if !hasValue {
+			if field.Tag.Get("required") == "true" {
+				fieldErrors = append(fieldErrors, newFieldError(current, "", ErrRequiredField))
+			} else {
+				unresolved = append(unresolved, current)
+			}
+
+			continue
+		}
+
+		err := decodeValue(fieldValue, field, value)
+		if err != nil {
+			fieldErrors = append(fieldErrors, newFieldError(current, "", fmt.Errorf("%w: %w", ErrInvalidFieldValue, err)))
+			continue
 		}
 
-		fieldValue.SetString(value)
+		populated = append(populated, current)
+		provenance.add(current, sources)
+	}
+
+	// Validate the fields against the rules of their validate tags. Fields without a value are only checked against
+	// the rules that tell if something was configured (nonempty and min), so the other rules keep them optional
+	validate := func(current Field, resolved bool) {
+		fieldValue := current.parentValue.FieldByIndex(current.field.Index)
+
+		err := validateField(fieldValue, current.field, resolved)
+		if err != nil {
+			fieldErrors = append(fieldErrors, newFieldError(current, "", err))
+		}
+	}
+
+	for _, current := range populated {
+		validate(current, true)
+	}
+
+	for _, current := range unresolved {
+		validate(current, false)
+	}
+
+	if len(fieldErrors) != 0 {
+		// Sort the errors by path, since the fields are not visited in order
+		sort.SliceStable(fieldErrors, func(i, j int) bool {
+			return fieldErrors[i].Path < fieldErrors[j].Path
+		})
+
+		return provenance, &PopulateError{Errors: fieldErrors}
+	}
+
+	return provenance, nil
+}
+
+// fieldSources returns the names of the providers allowed by the source tag of a field (e.g. source:"env,json"),
+// or nil if the field has no source tag and every provider is allowed
+func fieldSources(field reflect.StructField) map[string]bool {
+	tag := field.Tag.Get("source")
+	if tag == "" {
+		return nil
+	}
+
+	sources := map[string]bool{}
+	for _, source := range strings.Split(tag, ",") {
+		if source = strings.TrimSpace(source); source != "" {
+			sources[source] = true
+		}
+	}
+
+	return sources
+}
+
+// unknownSources returns the sorted names of the allowed sources that match no registered provider
+func (gofig *Gofig) unknownSources(allowedSources map[string]bool) []string {
+	unknown := make([]string, 0)
+	for source := range allowedSources {
+		registered := false
+		for _, provider := range gofig.providers {
+			if provider.name == source {
+				registered = true
+				break
+			}
+		}
+
+		if !registered {
+			unknown = append(unknown, source)
+		}
+	}
+
+	sort.Strings(unknown)
+	return unknown
+}
+
+// getValue resolves the value of a field from a provider and reports whether it was found.
+// It prefers interfaces.ContextProvider, then the native value if the provider implements interfaces.RawProvider
+// and then interfaces.LookupProvider, falling back to GetValue where an empty string means not found
+func getValue(ctx context.Context, provider interfaces.Provider, fieldPath []string) (interface{}, bool, error) {
+	if contextProvider, ok := provider.(interfaces.ContextProvider); ok {
+		value, found, err := contextProvider.LookupValueContext(ctx, fieldPath)
+		if err != nil || !found {
+			return nil, false, err
+		}
+
+		return value, true, nil
+	}
+
+	if rawProvider, ok := provider.(interfaces.RawProvider); ok {
+		value, err := rawProvider.GetRawValue(fieldPath)
+		if err != nil || value == nil {
+			return nil, false, err
+		}
+
+		return value, true, nil
+	}
+
+	if lookupProvider, ok := provider.(interfaces.LookupProvider); ok {
+		value, found, err := lookupProvider.LookupValue(fieldPath)
+		if err != nil || !found {
+			return nil, false, err
+		}
+
+		return value, true, nil
+	}
+
+	value, err := provider.GetValue(fieldPath)
+	if err != nil || value == "" {
+		return nil, false, err
 	}
 
-	return nil
+	return value, true, nil
 }
@@ -1,8 +1,11 @@
 package gofig
 
 import (
+	"context"
+	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/darklam/gofig/mocks/interfaces"
 	"github.com/darklam/gofig/providers"
@@ -177,3 +180,592 @@ func TestGofig_PopulateConfigProviderPrecedence(t *testing.T) {
 	assert.Equal(t, cfg.Value2, "provider2")
 	assert.Equal(t, cfg.Value3, "provider3")
 }
+
+func TestGofig_PopulateConfigTypedFields(t *testing.T) {
+	// GIVEN
+	provider := interfaces.NewMockProvider(t)
+
+	provider.On("GetValue", []string{"port"}).Return("5432", nil)
+	provider.On("GetValue", []string{"debug"}).Return("true", nil)
+	provider.On("GetValue", []string{"ratio"}).Return("0.75", nil)
+	provider.On("GetValue", []string{"timeout"}).Return("1m30s", nil)
+	provider.On("GetValue", []string{"released"}).Return("2023-09-01", nil)
+	provider.On("GetValue", mock.Anything).Return("", nil)
+
+	gofig := NewGofig()
+
+	gofig.RegisterProvider(provider)
+
+	type config struct {
+		Port     int           `prop:"port"`
+		Workers  uint8         `prop:"workers" default:"4"`
+		Debug    bool          `prop:"debug"`
+		Ratio    float64       `prop:"ratio"`
+		Timeout  time.Duration `prop:"timeout"`
+		Released time.Time     `prop:"released" layout:"2006-01-02"`
+		Missing  int64         `prop:"missing"`
+	}
+
+	cfg := new(config)
+
+	// WHEN
+	err := gofig.PopulateConfig(cfg)
+
+	// THEN
+	assert.Nil(t, err)
+	assert.Equal(t, 5432, cfg.Port)
+	assert.Equal(t, uint8(4), cfg.Workers)
+	assert.Equal(t, true, cfg.Debug)
+	assert.Equal(t, 0.75, cfg.Ratio)
+	assert.Equal(t, 90*time.Second, cfg.Timeout)
+	assert.Equal(t, time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC), cfg.Released)
+	assert.Equal(t, int64(0), cfg.Missing)
+}
+
+func TestGofig_PopulateConfigInvalidTypedValue(t *testing.T) {
+	// GIVEN
+	provider := interfaces.NewMockProvider(t)
+
+	provider.On("GetValue", []string{"server", "port"}).Return("not-a-number", nil)
+
+	gofig := NewGofig()
+
+	gofig.RegisterProvider(provider)
+
+	type config struct {
+		Port int `prop:"server.port"`
+	}
+
+	cfg := new(config)
+
+	// WHEN
+	err := gofig.PopulateConfig(cfg)
+
+	// THEN
+	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, ErrInvalidFieldValue)
+	assert.Contains(t, err.Error(), "Port")
+	assert.Contains(t, err.Error(), "server.port")
+}
+
+func TestGofig_PopulateConfigUnsupportedType(t *testing.T) {
+	// GIVEN
+	gofig := NewGofig()
+
+	type config struct {
+		Values chan string `prop:"values"`
+	}
+
+	cfg := new(config)
+
+	// WHEN
+	err := gofig.PopulateConfig(cfg)
+
+	// THEN
+	assert.True(t, errors.Is(err, ErrUnsupportedFieldType))
+}
+
+func TestGofig_PopulateConfigSlicesAndMapsFromEnv(t *testing.T) {
+	// GIVEN
+	gofig := NewGofig()
+
+	gofig.RegisterProvider(providers.NewEnvProvider())
+
+	type config struct {
+		Hosts    []string          `prop:"allowed.hosts"`
+		Ports    []int             `prop:"ports" sep:";"`
+		Labels   map[string]string `prop:"labels"`
+		Features []string          `prop:"features" default:"a, b"`
+	}
+
+	t.Setenv("ALLOWED_HOSTS", "example.com, example.org")
+	t.Setenv("PORTS", "80;443")
+	t.Setenv("LABELS", "team=core,tier=backend")
+
+	cfg := new(config)
+
+	// WHEN
+	err := gofig.PopulateConfig(cfg)
+
+	// THEN
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"example.com", "example.org"}, cfg.Hosts)
+	assert.Equal(t, []int{80, 443}, cfg.Ports)
+	assert.Equal(t, map[string]string{"team": "core", "tier": "backend"}, cfg.Labels)
+	assert.Equal(t, []string{"a", "b"}, cfg.Features)
+}
+
+func TestGofig_PopulateConfigSlicesAndMapsFromJSON(t *testing.T) {
+	// GIVEN
+	tmpFile, err := os.CreateTemp("", "tests")
+	assert.Nil(t, err)
+
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.WriteString(`{
+		"hosts": ["example.com", "example.org"],
+		"ports": [80, 443],
+		"limits": {"cpu": 2, "memory": 512},
+		"port": 3000
+	}`)
+	assert.Nil(t, err)
+
+	jsonProvider, err := providers.NewJSONProvider(tmpFile.Name())
+	assert.Nil(t, err)
+
+	gofig := NewGofig()
+
+	gofig.RegisterProvider(jsonProvider)
+
+	type config struct {
+		Hosts  []string       `prop:"hosts"`
+		Ports  []uint16       `prop:"ports"`
+		Limits map[string]int `prop:"limits"`
+		Port   int            `prop:"port"`
+	}
+
+	cfg := new(config)
+
+	// WHEN
+	err = gofig.PopulateConfig(cfg)
+
+	// THEN
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"example.com", "example.org"}, cfg.Hosts)
+	assert.Equal(t, []uint16{80, 443}, cfg.Ports)
+	assert.Equal(t, map[string]int{"cpu": 2, "memory": 512}, cfg.Limits)
+	assert.Equal(t, 3000, cfg.Port)
+}
+
+func TestGofig_PopulateConfigNestedAndEmbeddedStructs(t *testing.T) {
+	// GIVEN
+	gofig := NewGofig()
+
+	gofig.RegisterProvider(providers.NewEnvProvider())
+
+	type logging struct {
+		Level string `prop:"log.level" default:"info"`
+	}
+
+	type Metrics struct {
+		Port int `prop:"port"`
+	}
+
+	type Tracing struct {
+		Endpoint string `prop:"endpoint"`
+	}
+
+	type pgConfig struct {
+		Host string `prop:"host"`
+	}
+
+	type config struct {
+		logging
+		Metrics  `prop:"metrics"`
+		*Tracing `prop:"tracing"`
+		Postgres pgConfig `prop:"postgres"`
+	}
+
+	t.Setenv("LOG_LEVEL", "debug")
+	t.Setenv("METRICS_PORT", "9090")
+	t.Setenv("TRACING_ENDPOINT", "http://collector")
+	t.Setenv("POSTGRES_HOST", "db")
+
+	cfg := new(config)
+
+	// WHEN
+	err := gofig.PopulateConfig(cfg)
+
+	// THEN
+	assert.Nil(t, err)
+	assert.Equal(t, "debug", cfg.Level)
+	assert.Equal(t, 9090, cfg.Port)
+	assert.Equal(t, "http://collector", cfg.Endpoint)
+	assert.Equal(t, "db", cfg.Postgres.Host)
+}
+
+func TestGofig_PopulateConfigExplicitEmptyValue(t *testing.T) {
+	// GIVEN
+	provider := interfaces.NewMockLookupProvider(t)
+
+	provider.On("LookupValue", []string{"password"}).Return("", true, nil)
+	provider.On("LookupValue", []string{"port"}).Return("", true, nil)
+	provider.On("LookupValue", []string{"host"}).Return("", false, nil)
+
+	gofig := NewGofig()
+
+	gofig.RegisterProvider(provider)
+
+	type config struct {
+		Password string `prop:"password" default:"changeme"`
+		Port     int    `prop:"port" default:"5432"`
+		Host     string `prop:"host" default:"localhost"`
+	}
+
+	cfg := new(config)
+
+	// WHEN
+	err := gofig.PopulateConfig(cfg)
+
+	// THEN
+	provider.AssertNotCalled(t, "GetValue", mock.Anything)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "", cfg.Password)
+	assert.Equal(t, 0, cfg.Port)
+	assert.Equal(t, "localhost", cfg.Host)
+}
+
+func TestGofig_PopulateConfigAggregatedErrors(t *testing.T) {
+	// GIVEN
+	provider := interfaces.NewMockProvider(t)
+
+	providerErr := errors.New("something went wrong")
+
+	provider.On("GetValue", []string{"postgres", "port"}).Return("not-a-number", nil)
+	provider.On("GetValue", []string{"postgres", "host"}).Return("", providerErr)
+	provider.On("GetValue", []string{"debug"}).Return("maybe", nil)
+
+	gofig := NewGofig()
+
+	gofig.RegisterProvider(provider)
+
+	type pgConfig struct {
+		Host string `prop:"host" default:"localhost"`
+		Port int    `prop:"port"`
+	}
+
+	type config struct {
+		Postgres *pgConfig `prop:"postgres"`
+		Debug    bool      `prop:"debug"`
+	}
+
+	cfg := new(config)
+
+	// WHEN
+	err := gofig.PopulateConfig(cfg)
+
+	// THEN
+	var populateErr *PopulateError
+	assert.True(t, errors.As(err, &populateErr))
+	assert.Len(t, populateErr.Errors, 3)
+	assert.ErrorIs(t, err, providerErr)
+	assert.ErrorIs(t, err, ErrInvalidFieldValue)
+
+	assert.Equal(t, "debug", populateErr.Errors[0].Path)
+	assert.Equal(t, "Debug", populateErr.Errors[0].Field)
+	assert.Equal(t, "", populateErr.Errors[0].Provider)
+	assert.ErrorIs(t, populateErr.Errors[0], ErrInvalidFieldValue)
+
+	assert.Equal(t, "postgres.host", populateErr.Errors[1].Path)
+	assert.Equal(t, "Postgres.Host", populateErr.Errors[1].Field)
+	assert.Equal(t, "*interfaces.MockProvider", populateErr.Errors[1].Provider)
+	assert.ErrorIs(t, populateErr.Errors[1], providerErr)
+
+	assert.Equal(t, "postgres.port", populateErr.Errors[2].Path)
+	assert.Equal(t, "Postgres.Port", populateErr.Errors[2].Field)
+}
+
+func TestGofig_PopulateConfigRequiredAndValidation(t *testing.T) {
+	// GIVEN
+	provider := interfaces.NewMockLookupProvider(t)
+
+	provider.On("LookupValue", []string{"port"}).Return("0", true, nil)
+	provider.On("LookupValue", []string{"log", "level"}).Return("trace", true, nil)
+	provider.On("LookupValue", []string{"host"}).Return("", true, nil)
+	provider.On("LookupValue", mock.Anything).Return("", false, nil)
+
+	gofig := NewGofig()
+
+	gofig.RegisterProvider(provider)
+
+	type config struct {
+		Password string `prop:"password" required:"true"`
+		Port     int    `prop:"port" validate:"min=1,max=65535"`
+		LogLevel string `prop:"log.level" validate:"oneof=debug info warn"`
+		Host     string `prop:"host" required:"true" validate:"nonempty"`
+		User     string `prop:"user" default:"admin" required:"true"`
+		Timeout  int    `prop:"timeout" validate:"min=1"`
+		Endpoint string `prop:"endpoint" validate:"url"`
+		Mode     string `prop:"mode" validate:"oneof=a b"`
+	}
+
+	cfg := new(config)
+
+	// WHEN
+	err := gofig.PopulateConfig(cfg)
+
+	// THEN
+	var populateErr *PopulateError
+	assert.True(t, errors.As(err, &populateErr))
+	assert.Len(t, populateErr.Errors, 5)
+
+	assert.Equal(t, "host", populateErr.Errors[0].Path)
+	assert.ErrorIs(t, populateErr.Errors[0], ErrValidation)
+
+	assert.Equal(t, "log.level", populateErr.Errors[1].Path)
+	assert.ErrorIs(t, populateErr.Errors[1], ErrValidation)
+
+	assert.Equal(t, "password", populateErr.Errors[2].Path)
+	assert.ErrorIs(t, populateErr.Errors[2], ErrRequiredField)
+
+	assert.Equal(t, "port", populateErr.Errors[3].Path)
+	assert.ErrorIs(t, populateErr.Errors[3], ErrValidation)
+
+	// Fields without a value are only checked against nonempty and min, so the unset endpoint and mode are valid
+	assert.Equal(t, "timeout", populateErr.Errors[4].Path)
+	assert.ErrorIs(t, populateErr.Errors[4], ErrValidation)
+
+	assert.Equal(t, "admin", cfg.User)
+}
+
+func TestGofig_PopulateConfigWithProvenance(t *testing.T) {
+	// GIVEN
+	provider1 := interfaces.NewMockProvider(t)
+	provider2 := interfaces.NewMockLookupProvider(t)
+
+	provider1.On("GetValue", []string{"postgres", "host"}).Return("db", nil)
+	provider1.On("GetValue", mock.Anything).Return("", nil)
+
+	provider2.On("LookupValue", []string{"postgres", "host"}).Return("db.internal", true, nil)
+	provider2.On("LookupValue", mock.Anything).Return("", false, nil)
+
+	gofig := NewGofig()
+
+	gofig.RegisterProvider(provider1)
+	gofig.RegisterProvider(provider2)
+
+	type pgConfig struct {
+		Host string `prop:"host" default:"localhost"`
+		Port int    `prop:"port" default:"5432"`
+		User string `prop:"user"`
+	}
+
+	type config struct {
+		Postgres *pgConfig `prop:"postgres"`
+	}
+
+	cfg := new(config)
+
+	// WHEN
+	provenance, err := gofig.PopulateConfigWithProvenance(cfg)
+
+	// THEN
+	assert.Nil(t, err)
+	assert.Len(t, provenance, 2)
+
+	assert.Equal(t, FieldProvenance{
+		Field:  "Postgres.Host",
+		Source: Source{Provider: "*interfaces.MockLookupProvider", Value: "db.internal"},
+		Overridden: []Source{
+			{Provider: DefaultSource, Value: "localhost"},
+			{Provider: "*interfaces.MockProvider", Value: "db"},
+		},
+	}, provenance["postgres.host"])
+
+	assert.Equal(t, FieldProvenance{
+		Field:      "Postgres.Port",
+		Source:     Source{Provider: DefaultSource, Value: "5432"},
+		Overridden: []Source{},
+	}, provenance["postgres.port"])
+}
+
+func TestGofig_PopulateConfigRawProvider(t *testing.T) {
+	// GIVEN
+	provider := interfaces.NewMockRawProvider(t)
+
+	provider.On("GetRawValue", []string{"hosts"}).Return([]interface{}{"a,b", "c"}, nil)
+	provider.On("GetRawValue", []string{"port"}).Return(float64(8080), nil)
+	provider.On("GetRawValue", mock.Anything).Return(nil, nil)
+
+	gofig := NewGofig()
+
+	gofig.RegisterProvider(provider)
+
+	type config struct {
+		Hosts []string `prop:"hosts"`
+		Port  int      `prop:"port"`
+		Name  string   `prop:"name" default:"app"`
+	}
+
+	cfg := new(config)
+
+	// WHEN
+	err := gofig.PopulateConfig(cfg)
+
+	// THEN
+	provider.AssertNotCalled(t, "GetValue", mock.Anything)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a,b", "c"}, cfg.Hosts)
+	assert.Equal(t, 8080, cfg.Port)
+	assert.Equal(t, "app", cfg.Name)
+}
+
+func TestGofig_PopulateConfigContext(t *testing.T) {
+	// GIVEN
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	provider := interfaces.NewMockContextProvider(t)
+
+	provider.On("LookupValueContext", ctx, []string{"hosts"}).Return([]interface{}{"a", "b"}, true, nil)
+
+	gofig := NewGofig()
+
+	gofig.RegisterProvider(provider)
+
+	type config struct {
+		Hosts []string `prop:"hosts"`
+	}
+
+	cfg := new(config)
+
+	// WHEN
+	err := gofig.PopulateConfigContext(ctx, cfg)
+
+	// THEN
+	provider.AssertNotCalled(t, "GetValue", mock.Anything)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, cfg.Hosts)
+}
+
+func TestGofig_PopulateConfigContextCancelled(t *testing.T) {
+	// GIVEN
+	ctx, cancel := context.WithCancel(context.Background())
+
+	provider := interfaces.NewMockContextProvider(t)
+
+	provider.
+		On("LookupValueContext", ctx, mock.Anything).
+		Run(func(args mock.Arguments) { cancel() }).
+		Return(nil, false, context.Canceled).
+		Once()
+
+	gofig := NewGofig()
+
+	gofig.RegisterProvider(provider)
+
+	type config struct {
+		First  string `prop:"first"`
+		Second string `prop:"second"`
+	}
+
+	cfg := new(config)
+
+	// WHEN
+	err := gofig.PopulateConfigContext(ctx, cfg)
+
+	// THEN
+	assert.ErrorIs(t, err, context.Canceled)
+	provider.AssertNumberOfCalls(t, "LookupValueContext", 1)
+}
+
+func TestGofig_RegisterNamedProviderCollision(t *testing.T) {
+	// GIVEN
+	provider1 := interfaces.NewMockProvider(t)
+	provider2 := interfaces.NewMockProvider(t)
+	provider3 := interfaces.NewMockProvider(t)
+
+	provider2.On("GetValue", []string{"value"}).Return("provider2", nil)
+	provider3.On("GetValue", []string{"value"}).Return("provider3", nil)
+
+	gofig := NewGofig()
+
+	gofig.RegisterNamedProvider("json", provider1)
+	gofig.RegisterNamedProvider("env", provider2)
+	gofig.RegisterNamedProvider("json", provider3)
+
+	type config struct {
+		Value string `prop:"value"`
+	}
+
+	cfg := new(config)
+
+	// WHEN
+	provenance, err := gofig.PopulateConfigWithProvenance(cfg)
+
+	// THEN
+	provider1.AssertNotCalled(t, "GetValue", mock.Anything)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "provider3", cfg.Value)
+	assert.Equal(t, Source{Provider: "json", Value: "provider3"}, provenance["value"].Source)
+	assert.Equal(t, []Source{{Provider: "env", Value: "provider2"}}, provenance["value"].Overridden)
+}
+
+func TestGofig_PopulateConfigSourceTag(t *testing.T) {
+	// GIVEN
+	envProvider := interfaces.NewMockProvider(t)
+	jsonProvider := interfaces.NewMockProvider(t)
+	vaultProvider := interfaces.NewMockProvider(t)
+	unnamedProvider := interfaces.NewMockProvider(t)
+
+	envProvider.On("GetValue", mock.Anything).Return("env", nil)
+	jsonProvider.On("GetValue", mock.Anything).Return("json", nil)
+	vaultProvider.On("GetValue", []string{"password"}).Return("vault", nil)
+	vaultProvider.On("GetValue", mock.Anything).Return("", nil)
+	unnamedProvider.On("GetValue", []string{"any"}).Return("unnamed", nil)
+
+	gofig := NewGofig()
+
+	gofig.RegisterNamedProvider("env", envProvider)
+	gofig.RegisterNamedProvider("vault", vaultProvider)
+	gofig.RegisterNamedProvider("json", jsonProvider)
+	gofig.RegisterProvider(unnamedProvider)
+
+	type config struct {
+		Password string `prop:"password" source:"vault"`
+		Host     string `prop:"host" source:"env, vault"`
+		Any      string `prop:"any"`
+	}
+
+	cfg := new(config)
+
+	// WHEN
+	err := gofig.PopulateConfig(cfg)
+
+	// THEN
+	vaultProvider.AssertNumberOfCalls(t, "GetValue", 3)
+	jsonProvider.AssertNumberOfCalls(t, "GetValue", 1)
+	unnamedProvider.AssertNumberOfCalls(t, "GetValue", 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "vault", cfg.Password)
+	assert.Equal(t, "env", cfg.Host)
+	assert.Equal(t, "unnamed", cfg.Any)
+}
+
+func TestGofig_PopulateConfigUnknownSource(t *testing.T) {
+	// GIVEN
+	vaultProvider := interfaces.NewMockProvider(t)
+
+	vaultProvider.On("GetValue", []string{"host"}).Return("vault", nil)
+
+	gofig := NewGofig()
+
+	gofig.RegisterNamedProvider("vault", vaultProvider)
+
+	type config struct {
+		Password string `prop:"password" default:"changeme" source:"valut"`
+		Host     string `prop:"host" source:"vault"`
+	}
+
+	cfg := new(config)
+
+	// WHEN
+	err := gofig.PopulateConfig(cfg)
+
+	// THEN
+	var populateErr *PopulateError
+	assert.True(t, errors.As(err, &populateErr))
+	assert.Len(t, populateErr.Errors, 1)
+
+	assert.Equal(t, "password", populateErr.Errors[0].Path)
+	assert.ErrorIs(t, populateErr.Errors[0], ErrUnknownSource)
+	assert.ErrorContains(t, populateErr.Errors[0], "valut")
+
+	assert.Equal(t, "vault", cfg.Host)
+	assert.Empty(t, cfg.Password)
+}
@@ -1,6 +1,8 @@
 // Package interfaces includes common interfaces used in the project
 package interfaces
 
+import "context"
+
 // Provider is the interface that every provider must implement to be used with Gofig
 //
 //counterfeiter:generate . Provider
@@ -9,3 +11,48 @@ type Provider interface {
 	// If a value is not found, it should return an empty string without an error
 	GetValue(fieldPath []string) (string, error)
 }
+
+// RawProvider is an optional interface for providers that can return values in their native form
+// (e.g. JSON arrays and objects). If a provider implements it, Gofig uses it instead of GetValue
+type RawProvider interface {
+	Provider
+
+	// GetRawValue returns the value for a struct field given its path in the struct
+	// The value can be a string, a number, a bool, a []interface{} or a map[string]interface{}
+	// If a value is not found, it should return nil without an error. Any other value (including an empty string)
+	// is considered found
+	GetRawValue(fieldPath []string) (interface{}, error)
+}
+
+// LookupProvider is an optional interface for providers that can tell apart a missing value from an empty one.
+// If a provider implements it, Gofig uses it instead of GetValue, so explicit empty values override the defaults
+type LookupProvider interface {
+	Provider
+
+	// LookupValue returns the value for a struct field given its path in the struct
+	// and whether the value was found
+	LookupValue(fieldPath []string) (string, bool, error)
+}
+
+// ContextProvider is an optional interface for providers that resolve values with a context (e.g. over the network),
+// so they can be cancelled or bounded by a deadline. If a provider implements it, Gofig uses it instead of the
+// other lookup methods and passes it the context given to PopulateConfigContext
+type ContextProvider interface {
+	Provider
+
+	// LookupValueContext returns the value for a struct field given its path in the struct
+	// and whether the value was found. The value can be a string or any of the native values of RawProvider
+	LookupValueContext(ctx context.Context, fieldPath []string) (interface{}, bool, error)
+}
+
+// WatchableProvider is an optional interface for providers whose values can change while the application runs
+// (e.g. edited files or rotated secrets). Gofig watches these providers when populating a config with Watch
+type WatchableProvider interface {
+	Provider
+
+	// Watch watches the source of the provider for changes until the context is done.
+	// Every change is signaled on the returned channel, either with nil after the provider has reloaded its values,
+	// or with the error that prevented reloading them (in which case the previous values are kept).
+	// The channel is closed when the context is done
+	Watch(ctx context.Context) (<-chan error, error)
+}
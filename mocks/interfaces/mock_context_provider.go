@@ -0,0 +1,150 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockContextProvider is an autogenerated mock type for the ContextProvider type
+type MockContextProvider struct {
+	mock.Mock
+}
+
+type MockContextProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockContextProvider) EXPECT() *MockContextProvider_Expecter {
+	return &MockContextProvider_Expecter{mock: &_m.Mock}
+}
+
+// GetValue provides a mock function with given fields: fieldPath
+func (_m *MockContextProvider) GetValue(fieldPath []string) (string, error) {
+	ret := _m.Called(fieldPath)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (string, error)); ok {
+		return rf(fieldPath)
+	}
+	if rf, ok := ret.Get(0).(func([]string) string); ok {
+		r0 = rf(fieldPath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(fieldPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContextProvider_GetValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetValue'
+type MockContextProvider_GetValue_Call struct {
+	*mock.Call
+}
+
+// GetValue is a helper method to define mock.On call
+//   - fieldPath []string
+func (_e *MockContextProvider_Expecter) GetValue(fieldPath interface{}) *MockContextProvider_GetValue_Call {
+	return &MockContextProvider_GetValue_Call{Call: _e.mock.On("GetValue", fieldPath)}
+}
+
+func (_c *MockContextProvider_GetValue_Call) Run(run func(fieldPath []string)) *MockContextProvider_GetValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockContextProvider_GetValue_Call) Return(_a0 string, _a1 error) *MockContextProvider_GetValue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContextProvider_GetValue_Call) RunAndReturn(run func([]string) (string, error)) *MockContextProvider_GetValue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LookupValueContext provides a mock function with given fields: ctx, fieldPath
+func (_m *MockContextProvider) LookupValueContext(ctx context.Context, fieldPath []string) (interface{}, bool, error) {
+	ret := _m.Called(ctx, fieldPath)
+
+	var r0 interface{}
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (interface{}, bool, error)); ok {
+		return rf(ctx, fieldPath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) interface{}); ok {
+		r0 = rf(ctx, fieldPath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) bool); ok {
+		r1 = rf(ctx, fieldPath)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []string) error); ok {
+		r2 = rf(ctx, fieldPath)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockContextProvider_LookupValueContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LookupValueContext'
+type MockContextProvider_LookupValueContext_Call struct {
+	*mock.Call
+}
+
+// LookupValueContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fieldPath []string
+func (_e *MockContextProvider_Expecter) LookupValueContext(ctx interface{}, fieldPath interface{}) *MockContextProvider_LookupValueContext_Call {
+	return &MockContextProvider_LookupValueContext_Call{Call: _e.mock.On("LookupValueContext", ctx, fieldPath)}
+}
+
+func (_c *MockContextProvider_LookupValueContext_Call) Run(run func(ctx context.Context, fieldPath []string)) *MockContextProvider_LookupValueContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockContextProvider_LookupValueContext_Call) Return(_a0 interface{}, _a1 bool, _a2 error) *MockContextProvider_LookupValueContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockContextProvider_LookupValueContext_Call) RunAndReturn(run func(context.Context, []string) (interface{}, bool, error)) *MockContextProvider_LookupValueContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockContextProvider creates a new instance of MockContextProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockContextProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockContextProvider {
+	mock := &MockContextProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
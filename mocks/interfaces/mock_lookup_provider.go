@@ -0,0 +1,143 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package interfaces
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockLookupProvider is an autogenerated mock type for the LookupProvider type
+type MockLookupProvider struct {
+	mock.Mock
+}
+
+type MockLookupProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLookupProvider) EXPECT() *MockLookupProvider_Expecter {
+	return &MockLookupProvider_Expecter{mock: &_m.Mock}
+}
+
+// GetValue provides a mock function with given fields: fieldPath
+func (_m *MockLookupProvider) GetValue(fieldPath []string) (string, error) {
+	ret := _m.Called(fieldPath)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (string, error)); ok {
+		return rf(fieldPath)
+	}
+	if rf, ok := ret.Get(0).(func([]string) string); ok {
+		r0 = rf(fieldPath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(fieldPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLookupProvider_GetValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetValue'
+type MockLookupProvider_GetValue_Call struct {
+	*mock.Call
+}
+
+// GetValue is a helper method to define mock.On call
+//   - fieldPath []string
+func (_e *MockLookupProvider_Expecter) GetValue(fieldPath interface{}) *MockLookupProvider_GetValue_Call {
+	return &MockLookupProvider_GetValue_Call{Call: _e.mock.On("GetValue", fieldPath)}
+}
+
+func (_c *MockLookupProvider_GetValue_Call) Run(run func(fieldPath []string)) *MockLookupProvider_GetValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockLookupProvider_GetValue_Call) Return(_a0 string, _a1 error) *MockLookupProvider_GetValue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLookupProvider_GetValue_Call) RunAndReturn(run func([]string) (string, error)) *MockLookupProvider_GetValue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LookupValue provides a mock function with given fields: fieldPath
+func (_m *MockLookupProvider) LookupValue(fieldPath []string) (string, bool, error) {
+	ret := _m.Called(fieldPath)
+
+	var r0 string
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func([]string) (string, bool, error)); ok {
+		return rf(fieldPath)
+	}
+	if rf, ok := ret.Get(0).(func([]string) string); ok {
+		r0 = rf(fieldPath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) bool); ok {
+		r1 = rf(fieldPath)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func([]string) error); ok {
+		r2 = rf(fieldPath)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockLookupProvider_LookupValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LookupValue'
+type MockLookupProvider_LookupValue_Call struct {
+	*mock.Call
+}
+
+// LookupValue is a helper method to define mock.On call
+//   - fieldPath []string
+func (_e *MockLookupProvider_Expecter) LookupValue(fieldPath interface{}) *MockLookupProvider_LookupValue_Call {
+	return &MockLookupProvider_LookupValue_Call{Call: _e.mock.On("LookupValue", fieldPath)}
+}
+
+func (_c *MockLookupProvider_LookupValue_Call) Run(run func(fieldPath []string)) *MockLookupProvider_LookupValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockLookupProvider_LookupValue_Call) Return(_a0 string, _a1 bool, _a2 error) *MockLookupProvider_LookupValue_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockLookupProvider_LookupValue_Call) RunAndReturn(run func([]string) (string, bool, error)) *MockLookupProvider_LookupValue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLookupProvider creates a new instance of MockLookupProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLookupProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLookupProvider {
+	mock := &MockLookupProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,138 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package interfaces
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockRawProvider is an autogenerated mock type for the RawProvider type
+type MockRawProvider struct {
+	mock.Mock
+}
+
+type MockRawProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRawProvider) EXPECT() *MockRawProvider_Expecter {
+	return &MockRawProvider_Expecter{mock: &_m.Mock}
+}
+
+// GetRawValue provides a mock function with given fields: fieldPath
+func (_m *MockRawProvider) GetRawValue(fieldPath []string) (interface{}, error) {
+	ret := _m.Called(fieldPath)
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (interface{}, error)); ok {
+		return rf(fieldPath)
+	}
+	if rf, ok := ret.Get(0).(func([]string) interface{}); ok {
+		r0 = rf(fieldPath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(fieldPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRawProvider_GetRawValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRawValue'
+type MockRawProvider_GetRawValue_Call struct {
+	*mock.Call
+}
+
+// GetRawValue is a helper method to define mock.On call
+//   - fieldPath []string
+func (_e *MockRawProvider_Expecter) GetRawValue(fieldPath interface{}) *MockRawProvider_GetRawValue_Call {
+	return &MockRawProvider_GetRawValue_Call{Call: _e.mock.On("GetRawValue", fieldPath)}
+}
+
+func (_c *MockRawProvider_GetRawValue_Call) Run(run func(fieldPath []string)) *MockRawProvider_GetRawValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockRawProvider_GetRawValue_Call) Return(_a0 interface{}, _a1 error) *MockRawProvider_GetRawValue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRawProvider_GetRawValue_Call) RunAndReturn(run func([]string) (interface{}, error)) *MockRawProvider_GetRawValue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetValue provides a mock function with given fields: fieldPath
+func (_m *MockRawProvider) GetValue(fieldPath []string) (string, error) {
+	ret := _m.Called(fieldPath)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (string, error)); ok {
+		return rf(fieldPath)
+	}
+	if rf, ok := ret.Get(0).(func([]string) string); ok {
+		r0 = rf(fieldPath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(fieldPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRawProvider_GetValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetValue'
+type MockRawProvider_GetValue_Call struct {
+	*mock.Call
+}
+
+// GetValue is a helper method to define mock.On call
+//   - fieldPath []string
+func (_e *MockRawProvider_Expecter) GetValue(fieldPath interface{}) *MockRawProvider_GetValue_Call {
+	return &MockRawProvider_GetValue_Call{Call: _e.mock.On("GetValue", fieldPath)}
+}
+
+func (_c *MockRawProvider_GetValue_Call) Run(run func(fieldPath []string)) *MockRawProvider_GetValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockRawProvider_GetValue_Call) Return(_a0 string, _a1 error) *MockRawProvider_GetValue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRawProvider_GetValue_Call) RunAndReturn(run func([]string) (string, error)) *MockRawProvider_GetValue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRawProvider creates a new instance of MockRawProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRawProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRawProvider {
+	mock := &MockRawProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,142 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWatchableProvider is an autogenerated mock type for the WatchableProvider type
+type MockWatchableProvider struct {
+	mock.Mock
+}
+
+type MockWatchableProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWatchableProvider) EXPECT() *MockWatchableProvider_Expecter {
+	return &MockWatchableProvider_Expecter{mock: &_m.Mock}
+}
+
+// GetValue provides a mock function with given fields: fieldPath
+func (_m *MockWatchableProvider) GetValue(fieldPath []string) (string, error) {
+	ret := _m.Called(fieldPath)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (string, error)); ok {
+		return rf(fieldPath)
+	}
+	if rf, ok := ret.Get(0).(func([]string) string); ok {
+		r0 = rf(fieldPath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(fieldPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWatchableProvider_GetValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetValue'
+type MockWatchableProvider_GetValue_Call struct {
+	*mock.Call
+}
+
+// GetValue is a helper method to define mock.On call
+//   - fieldPath []string
+func (_e *MockWatchableProvider_Expecter) GetValue(fieldPath interface{}) *MockWatchableProvider_GetValue_Call {
+	return &MockWatchableProvider_GetValue_Call{Call: _e.mock.On("GetValue", fieldPath)}
+}
+
+func (_c *MockWatchableProvider_GetValue_Call) Run(run func(fieldPath []string)) *MockWatchableProvider_GetValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockWatchableProvider_GetValue_Call) Return(_a0 string, _a1 error) *MockWatchableProvider_GetValue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWatchableProvider_GetValue_Call) RunAndReturn(run func([]string) (string, error)) *MockWatchableProvider_GetValue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Watch provides a mock function with given fields: ctx
+func (_m *MockWatchableProvider) Watch(ctx context.Context) (<-chan error, error) {
+	ret := _m.Called(ctx)
+
+	var r0 <-chan error
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (<-chan error, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan error); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan error)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWatchableProvider_Watch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Watch'
+type MockWatchableProvider_Watch_Call struct {
+	*mock.Call
+}
+
+// Watch is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWatchableProvider_Expecter) Watch(ctx interface{}) *MockWatchableProvider_Watch_Call {
+	return &MockWatchableProvider_Watch_Call{Call: _e.mock.On("Watch", ctx)}
+}
+
+func (_c *MockWatchableProvider_Watch_Call) Run(run func(ctx context.Context)) *MockWatchableProvider_Watch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockWatchableProvider_Watch_Call) Return(_a0 <-chan error, _a1 error) *MockWatchableProvider_Watch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWatchableProvider_Watch_Call) RunAndReturn(run func(context.Context) (<-chan error, error)) *MockWatchableProvider_Watch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWatchableProvider creates a new instance of MockWatchableProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWatchableProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWatchableProvider {
+	mock := &MockWatchableProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
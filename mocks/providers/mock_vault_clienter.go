@@ -7,7 +7,9 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
-	time "time"
+	schema "github.com/hashicorp/vault-client-go/schema"
+
+	vault "github.com/hashicorp/vault-client-go"
 )
 
 // MockVaultClienter is an autogenerated mock type for the VaultClienter type
@@ -24,17 +26,29 @@ func (_m *MockVaultClienter) EXPECT() *MockVaultClienter_Expecter {
 }
 
 // AppRoleLogin provides a mock function with given fields: ctx, roleId, secretId
-func (_m *MockVaultClienter) AppRoleLogin(ctx context.Context, roleId string, secretId string) error {
+func (_m *MockVaultClienter) AppRoleLogin(ctx context.Context, roleId string, secretId string) (*vault.ResponseAuth, error) {
 	ret := _m.Called(ctx, roleId, secretId)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+	var r0 *vault.ResponseAuth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*vault.ResponseAuth, error)); ok {
+		return rf(ctx, roleId, secretId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *vault.ResponseAuth); ok {
 		r0 = rf(ctx, roleId, secretId)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vault.ResponseAuth)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, roleId, secretId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockVaultClienter_AppRoleLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppRoleLogin'
@@ -57,18 +71,73 @@ func (_c *MockVaultClienter_AppRoleLogin_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockVaultClienter_AppRoleLogin_Call) Return(_a0 error) *MockVaultClienter_AppRoleLogin_Call {
-	_c.Call.Return(_a0)
+func (_c *MockVaultClienter_AppRoleLogin_Call) Return(_a0 *vault.ResponseAuth, _a1 error) *MockVaultClienter_AppRoleLogin_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockVaultClienter_AppRoleLogin_Call) RunAndReturn(run func(context.Context, string, string) error) *MockVaultClienter_AppRoleLogin_Call {
+func (_c *MockVaultClienter_AppRoleLogin_Call) RunAndReturn(run func(context.Context, string, string) (*vault.ResponseAuth, error)) *MockVaultClienter_AppRoleLogin_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetValues provides a mock function with given fields: ctx, path, mountPath
-func (_m *MockVaultClienter) GetValues(ctx context.Context, path string, mountPath string) (map[string]interface{}, error) {
+// CertLogin provides a mock function with given fields: ctx, name
+func (_m *MockVaultClienter) CertLogin(ctx context.Context, name string) (*vault.ResponseAuth, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *vault.ResponseAuth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*vault.ResponseAuth, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *vault.ResponseAuth); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vault.ResponseAuth)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVaultClienter_CertLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CertLogin'
+type MockVaultClienter_CertLogin_Call struct {
+	*mock.Call
+}
+
+// CertLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockVaultClienter_Expecter) CertLogin(ctx interface{}, name interface{}) *MockVaultClienter_CertLogin_Call {
+	return &MockVaultClienter_CertLogin_Call{Call: _e.mock.On("CertLogin", ctx, name)}
+}
+
+func (_c *MockVaultClienter_CertLogin_Call) Run(run func(ctx context.Context, name string)) *MockVaultClienter_CertLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockVaultClienter_CertLogin_Call) Return(_a0 *vault.ResponseAuth, _a1 error) *MockVaultClienter_CertLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVaultClienter_CertLogin_Call) RunAndReturn(run func(context.Context, string) (*vault.ResponseAuth, error)) *MockVaultClienter_CertLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetKvV1Values provides a mock function with given fields: ctx, path, mountPath
+func (_m *MockVaultClienter) GetKvV1Values(ctx context.Context, path string, mountPath string) (map[string]interface{}, error) {
 	ret := _m.Called(ctx, path, mountPath)
 
 	var r0 map[string]interface{}
@@ -93,6 +162,117 @@ func (_m *MockVaultClienter) GetValues(ctx context.Context, path string, mountPa
 	return r0, r1
 }
 
+// MockVaultClienter_GetKvV1Values_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKvV1Values'
+type MockVaultClienter_GetKvV1Values_Call struct {
+	*mock.Call
+}
+
+// GetKvV1Values is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - mountPath string
+func (_e *MockVaultClienter_Expecter) GetKvV1Values(ctx interface{}, path interface{}, mountPath interface{}) *MockVaultClienter_GetKvV1Values_Call {
+	return &MockVaultClienter_GetKvV1Values_Call{Call: _e.mock.On("GetKvV1Values", ctx, path, mountPath)}
+}
+
+func (_c *MockVaultClienter_GetKvV1Values_Call) Run(run func(ctx context.Context, path string, mountPath string)) *MockVaultClienter_GetKvV1Values_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockVaultClienter_GetKvV1Values_Call) Return(_a0 map[string]interface{}, _a1 error) *MockVaultClienter_GetKvV1Values_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVaultClienter_GetKvV1Values_Call) RunAndReturn(run func(context.Context, string, string) (map[string]interface{}, error)) *MockVaultClienter_GetKvV1Values_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLogicalValues provides a mock function with given fields: ctx, path
+func (_m *MockVaultClienter) GetLogicalValues(ctx context.Context, path string) (*vault.Response[map[string]interface{}], error) {
+	ret := _m.Called(ctx, path)
+
+	var r0 *vault.Response[map[string]interface{}]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*vault.Response[map[string]interface{}], error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *vault.Response[map[string]interface{}]); ok {
+		r0 = rf(ctx, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vault.Response[map[string]interface{}])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVaultClienter_GetLogicalValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLogicalValues'
+type MockVaultClienter_GetLogicalValues_Call struct {
+	*mock.Call
+}
+
+// GetLogicalValues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+func (_e *MockVaultClienter_Expecter) GetLogicalValues(ctx interface{}, path interface{}) *MockVaultClienter_GetLogicalValues_Call {
+	return &MockVaultClienter_GetLogicalValues_Call{Call: _e.mock.On("GetLogicalValues", ctx, path)}
+}
+
+func (_c *MockVaultClienter_GetLogicalValues_Call) Run(run func(ctx context.Context, path string)) *MockVaultClienter_GetLogicalValues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockVaultClienter_GetLogicalValues_Call) Return(_a0 *vault.Response[map[string]interface{}], _a1 error) *MockVaultClienter_GetLogicalValues_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVaultClienter_GetLogicalValues_Call) RunAndReturn(run func(context.Context, string) (*vault.Response[map[string]interface{}], error)) *MockVaultClienter_GetLogicalValues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetValues provides a mock function with given fields: ctx, path, mountPath, version
+func (_m *MockVaultClienter) GetValues(ctx context.Context, path string, mountPath string, version int) (*vault.Response[schema.KvV2ReadResponse], error) {
+	ret := _m.Called(ctx, path, mountPath, version)
+
+	var r0 *vault.Response[schema.KvV2ReadResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) (*vault.Response[schema.KvV2ReadResponse], error)); ok {
+		return rf(ctx, path, mountPath, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) *vault.Response[schema.KvV2ReadResponse]); ok {
+		r0 = rf(ctx, path, mountPath, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vault.Response[schema.KvV2ReadResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, path, mountPath, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MockVaultClienter_GetValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetValues'
 type MockVaultClienter_GetValues_Call struct {
 	*mock.Call
@@ -102,34 +282,35 @@ type MockVaultClienter_GetValues_Call struct {
 //   - ctx context.Context
 //   - path string
 //   - mountPath string
-func (_e *MockVaultClienter_Expecter) GetValues(ctx interface{}, path interface{}, mountPath interface{}) *MockVaultClienter_GetValues_Call {
-	return &MockVaultClienter_GetValues_Call{Call: _e.mock.On("GetValues", ctx, path, mountPath)}
+//   - version int
+func (_e *MockVaultClienter_Expecter) GetValues(ctx interface{}, path interface{}, mountPath interface{}, version interface{}) *MockVaultClienter_GetValues_Call {
+	return &MockVaultClienter_GetValues_Call{Call: _e.mock.On("GetValues", ctx, path, mountPath, version)}
 }
 
-func (_c *MockVaultClienter_GetValues_Call) Run(run func(ctx context.Context, path string, mountPath string)) *MockVaultClienter_GetValues_Call {
+func (_c *MockVaultClienter_GetValues_Call) Run(run func(ctx context.Context, path string, mountPath string, version int)) *MockVaultClienter_GetValues_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
 	})
 	return _c
 }
 
-func (_c *MockVaultClienter_GetValues_Call) Return(_a0 map[string]interface{}, _a1 error) *MockVaultClienter_GetValues_Call {
+func (_c *MockVaultClienter_GetValues_Call) Return(_a0 *vault.Response[schema.KvV2ReadResponse], _a1 error) *MockVaultClienter_GetValues_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockVaultClienter_GetValues_Call) RunAndReturn(run func(context.Context, string, string) (map[string]interface{}, error)) *MockVaultClienter_GetValues_Call {
+func (_c *MockVaultClienter_GetValues_Call) RunAndReturn(run func(context.Context, string, string, int) (*vault.Response[schema.KvV2ReadResponse], error)) *MockVaultClienter_GetValues_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Initialize provides a mock function with given fields: url, requestTimeout
-func (_m *MockVaultClienter) Initialize(url string, requestTimeout time.Duration) error {
-	ret := _m.Called(url, requestTimeout)
+// Initialize provides a mock function with given fields: config, namespace
+func (_m *MockVaultClienter) Initialize(config vault.ClientConfiguration, namespace string) error {
+	ret := _m.Called(config, namespace)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, time.Duration) error); ok {
-		r0 = rf(url, requestTimeout)
+	if rf, ok := ret.Get(0).(func(vault.ClientConfiguration, string) error); ok {
+		r0 = rf(config, namespace)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -143,15 +324,15 @@ type MockVaultClienter_Initialize_Call struct {
 }
 
 // Initialize is a helper method to define mock.On call
-//   - url string
-//   - requestTimeout time.Duration
-func (_e *MockVaultClienter_Expecter) Initialize(url interface{}, requestTimeout interface{}) *MockVaultClienter_Initialize_Call {
-	return &MockVaultClienter_Initialize_Call{Call: _e.mock.On("Initialize", url, requestTimeout)}
+//   - config vault.ClientConfiguration
+//   - namespace string
+func (_e *MockVaultClienter_Expecter) Initialize(config interface{}, namespace interface{}) *MockVaultClienter_Initialize_Call {
+	return &MockVaultClienter_Initialize_Call{Call: _e.mock.On("Initialize", config, namespace)}
 }
 
-func (_c *MockVaultClienter_Initialize_Call) Run(run func(url string, requestTimeout time.Duration)) *MockVaultClienter_Initialize_Call {
+func (_c *MockVaultClienter_Initialize_Call) Run(run func(config vault.ClientConfiguration, namespace string)) *MockVaultClienter_Initialize_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(time.Duration))
+		run(args[0].(vault.ClientConfiguration), args[1].(string))
 	})
 	return _c
 }
@@ -161,23 +342,91 @@ func (_c *MockVaultClienter_Initialize_Call) Return(_a0 error) *MockVaultCliente
 	return _c
 }
 
-func (_c *MockVaultClienter_Initialize_Call) RunAndReturn(run func(string, time.Duration) error) *MockVaultClienter_Initialize_Call {
+func (_c *MockVaultClienter_Initialize_Call) RunAndReturn(run func(vault.ClientConfiguration, string) error) *MockVaultClienter_Initialize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JwtLogin provides a mock function with given fields: ctx, jwt, role
+func (_m *MockVaultClienter) JwtLogin(ctx context.Context, jwt string, role string) (*vault.ResponseAuth, error) {
+	ret := _m.Called(ctx, jwt, role)
+
+	var r0 *vault.ResponseAuth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*vault.ResponseAuth, error)); ok {
+		return rf(ctx, jwt, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *vault.ResponseAuth); ok {
+		r0 = rf(ctx, jwt, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vault.ResponseAuth)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, jwt, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVaultClienter_JwtLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JwtLogin'
+type MockVaultClienter_JwtLogin_Call struct {
+	*mock.Call
+}
+
+// JwtLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jwt string
+//   - role string
+func (_e *MockVaultClienter_Expecter) JwtLogin(ctx interface{}, jwt interface{}, role interface{}) *MockVaultClienter_JwtLogin_Call {
+	return &MockVaultClienter_JwtLogin_Call{Call: _e.mock.On("JwtLogin", ctx, jwt, role)}
+}
+
+func (_c *MockVaultClienter_JwtLogin_Call) Run(run func(ctx context.Context, jwt string, role string)) *MockVaultClienter_JwtLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockVaultClienter_JwtLogin_Call) Return(_a0 *vault.ResponseAuth, _a1 error) *MockVaultClienter_JwtLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVaultClienter_JwtLogin_Call) RunAndReturn(run func(context.Context, string, string) (*vault.ResponseAuth, error)) *MockVaultClienter_JwtLogin_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // KubernetesLogin provides a mock function with given fields: ctx, jwt, role
-func (_m *MockVaultClienter) KubernetesLogin(ctx context.Context, jwt string, role string) error {
+func (_m *MockVaultClienter) KubernetesLogin(ctx context.Context, jwt string, role string) (*vault.ResponseAuth, error) {
 	ret := _m.Called(ctx, jwt, role)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+	var r0 *vault.ResponseAuth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*vault.ResponseAuth, error)); ok {
+		return rf(ctx, jwt, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *vault.ResponseAuth); ok {
 		r0 = rf(ctx, jwt, role)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vault.ResponseAuth)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, jwt, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockVaultClienter_KubernetesLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KubernetesLogin'
@@ -200,12 +449,232 @@ func (_c *MockVaultClienter_KubernetesLogin_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockVaultClienter_KubernetesLogin_Call) Return(_a0 error) *MockVaultClienter_KubernetesLogin_Call {
-	_c.Call.Return(_a0)
+func (_c *MockVaultClienter_KubernetesLogin_Call) Return(_a0 *vault.ResponseAuth, _a1 error) *MockVaultClienter_KubernetesLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVaultClienter_KubernetesLogin_Call) RunAndReturn(run func(context.Context, string, string) (*vault.ResponseAuth, error)) *MockVaultClienter_KubernetesLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenewLease provides a mock function with given fields: ctx, leaseId
+func (_m *MockVaultClienter) RenewLease(ctx context.Context, leaseId string) (*vault.Response[map[string]interface{}], error) {
+	ret := _m.Called(ctx, leaseId)
+
+	var r0 *vault.Response[map[string]interface{}]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*vault.Response[map[string]interface{}], error)); ok {
+		return rf(ctx, leaseId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *vault.Response[map[string]interface{}]); ok {
+		r0 = rf(ctx, leaseId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vault.Response[map[string]interface{}])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, leaseId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVaultClienter_RenewLease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewLease'
+type MockVaultClienter_RenewLease_Call struct {
+	*mock.Call
+}
+
+// RenewLease is a helper method to define mock.On call
+//   - ctx context.Context
+//   - leaseId string
+func (_e *MockVaultClienter_Expecter) RenewLease(ctx interface{}, leaseId interface{}) *MockVaultClienter_RenewLease_Call {
+	return &MockVaultClienter_RenewLease_Call{Call: _e.mock.On("RenewLease", ctx, leaseId)}
+}
+
+func (_c *MockVaultClienter_RenewLease_Call) Run(run func(ctx context.Context, leaseId string)) *MockVaultClienter_RenewLease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockVaultClienter_RenewLease_Call) Return(_a0 *vault.Response[map[string]interface{}], _a1 error) *MockVaultClienter_RenewLease_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVaultClienter_RenewLease_Call) RunAndReturn(run func(context.Context, string) (*vault.Response[map[string]interface{}], error)) *MockVaultClienter_RenewLease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenewToken provides a mock function with given fields: ctx
+func (_m *MockVaultClienter) RenewToken(ctx context.Context) (*vault.ResponseAuth, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *vault.ResponseAuth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*vault.ResponseAuth, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *vault.ResponseAuth); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vault.ResponseAuth)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVaultClienter_RenewToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewToken'
+type MockVaultClienter_RenewToken_Call struct {
+	*mock.Call
+}
+
+// RenewToken is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockVaultClienter_Expecter) RenewToken(ctx interface{}) *MockVaultClienter_RenewToken_Call {
+	return &MockVaultClienter_RenewToken_Call{Call: _e.mock.On("RenewToken", ctx)}
+}
+
+func (_c *MockVaultClienter_RenewToken_Call) Run(run func(ctx context.Context)) *MockVaultClienter_RenewToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockVaultClienter_RenewToken_Call) Return(_a0 *vault.ResponseAuth, _a1 error) *MockVaultClienter_RenewToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVaultClienter_RenewToken_Call) RunAndReturn(run func(context.Context) (*vault.ResponseAuth, error)) *MockVaultClienter_RenewToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TokenLogin provides a mock function with given fields: ctx, token
+func (_m *MockVaultClienter) TokenLogin(ctx context.Context, token string) (*vault.ResponseAuth, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 *vault.ResponseAuth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*vault.ResponseAuth, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *vault.ResponseAuth); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vault.ResponseAuth)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVaultClienter_TokenLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TokenLogin'
+type MockVaultClienter_TokenLogin_Call struct {
+	*mock.Call
+}
+
+// TokenLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockVaultClienter_Expecter) TokenLogin(ctx interface{}, token interface{}) *MockVaultClienter_TokenLogin_Call {
+	return &MockVaultClienter_TokenLogin_Call{Call: _e.mock.On("TokenLogin", ctx, token)}
+}
+
+func (_c *MockVaultClienter_TokenLogin_Call) Run(run func(ctx context.Context, token string)) *MockVaultClienter_TokenLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockVaultClienter_TokenLogin_Call) Return(_a0 *vault.ResponseAuth, _a1 error) *MockVaultClienter_TokenLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVaultClienter_TokenLogin_Call) RunAndReturn(run func(context.Context, string) (*vault.ResponseAuth, error)) *MockVaultClienter_TokenLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserpassLogin provides a mock function with given fields: ctx, username, password
+func (_m *MockVaultClienter) UserpassLogin(ctx context.Context, username string, password string) (*vault.ResponseAuth, error) {
+	ret := _m.Called(ctx, username, password)
+
+	var r0 *vault.ResponseAuth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*vault.ResponseAuth, error)); ok {
+		return rf(ctx, username, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *vault.ResponseAuth); ok {
+		r0 = rf(ctx, username, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vault.ResponseAuth)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, username, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVaultClienter_UserpassLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserpassLogin'
+type MockVaultClienter_UserpassLogin_Call struct {
+	*mock.Call
+}
+
+// UserpassLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - password string
+func (_e *MockVaultClienter_Expecter) UserpassLogin(ctx interface{}, username interface{}, password interface{}) *MockVaultClienter_UserpassLogin_Call {
+	return &MockVaultClienter_UserpassLogin_Call{Call: _e.mock.On("UserpassLogin", ctx, username, password)}
+}
+
+func (_c *MockVaultClienter_UserpassLogin_Call) Run(run func(ctx context.Context, username string, password string)) *MockVaultClienter_UserpassLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockVaultClienter_UserpassLogin_Call) Return(_a0 *vault.ResponseAuth, _a1 error) *MockVaultClienter_UserpassLogin_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockVaultClienter_KubernetesLogin_Call) RunAndReturn(run func(context.Context, string, string) error) *MockVaultClienter_KubernetesLogin_Call {
+func (_c *MockVaultClienter_UserpassLogin_Call) RunAndReturn(run func(context.Context, string, string) (*vault.ResponseAuth, error)) *MockVaultClienter_UserpassLogin_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,46 @@
+package gofig
+
+import "strings"
+
+// DefaultSource is the provider name used in the provenance of values coming from the default tag
+const DefaultSource = "default"
+
+// Source is a value resolved for a field along with the provider it came from
+type Source struct {
+	// The name of the provider, or DefaultSource for the default tag
+	Provider string
+
+	// The value as returned by the provider
+	Value interface{}
+}
+
+// FieldProvenance describes where the final value of a field came from
+type FieldProvenance struct {
+	// The Go name of the field including its parent structs (e.g. Postgres.Host)
+	Field string
+
+	// The source of the final value of the field
+	Source Source
+
+	// The values that were overridden by the final one, ordered by precedence (lowest first)
+	Overridden []Source
+}
+
+// Provenance maps the full prop path of every populated field (e.g. postgres.host) to the provenance of its value.
+// Keep in mind that it contains the values as well, so it may include secrets
+type Provenance map[string]FieldProvenance
+
+// add records the provenance of a field given all the values resolved for it, ordered by precedence (lowest first)
+func (p Provenance) add(current Field, sources []Source) {
+	if len(sources) == 0 {
+		return
+	}
+
+	last := len(sources) - 1
+
+	p[strings.Join(current.fullPath, ".")] = FieldProvenance{
+		Field:      strings.Join(current.goPath, "."),
+		Source:     sources[last],
+		Overridden: sources[:last],
+	}
+}
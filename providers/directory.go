@@ -0,0 +1,110 @@
+package providers
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+)
+
+// KeyCase is the case the file names of a DirectoryProvider are written in
+type KeyCase int
+
+const (
+	// KeyCaseUnchanged uses the prop path as it is (e.g. postgres.password)
+	KeyCaseUnchanged KeyCase = iota
+
+	// KeyCaseUpper uses the uppercase prop path (e.g. POSTGRES.PASSWORD)
+	KeyCaseUpper
+
+	// KeyCaseLower uses the lowercase prop path (e.g. postgres.password for a Postgres.Password prop)
+	KeyCaseLower
+)
+
+// DirectoryProvider resolves values from a directory with one file per key, like the secrets and config maps
+// Kubernetes mounts as volumes. The prop path is joined with Separator to get the file name, so the prop
+// postgres.password resolves the file postgres.password under the root. Files are read on every lookup,
+// so updates of the mounted files are picked up, and trailing newlines are trimmed from their contents
+type DirectoryProvider struct {
+	// Separator joins the parts of the prop path into a file name (default "."). With "/" the parts of the prop
+	// path are resolved as subdirectories
+	Separator string
+
+	// Case is the case of the file names (default KeyCaseUnchanged)
+	Case KeyCase
+
+	fsys fs.FS
+	root string
+}
+
+func NewDirectoryProvider(root string) (*DirectoryProvider, error) {
+	return NewDirectoryProviderFromFs(os.DirFS(root), ".")
+}
+
+func NewDirectoryProviderFromFs(fsys fs.FS, root string) (*DirectoryProvider, error) {
+	info, err := fs.Stat(fsys, root)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", root)
+	}
+
+	return &DirectoryProvider{fsys: fsys, root: root}, nil
+}
+
+func (dp *DirectoryProvider) GetValue(fieldPath []string) (string, error) {
+	value, _, err := dp.LookupValue(fieldPath)
+	return value, err
+}
+
+// LookupValue returns the contents of the file for the field without the trailing newlines.
+// Missing files and directories are reported as not found
+func (dp *DirectoryProvider) LookupValue(fieldPath []string) (string, bool, error) {
+	name := dp.fileName(fieldPath)
+	// Reject the names that would escape the root (e.g. with a ".." part)
+	if !fs.ValidPath(name) {
+		return "", false, nil
+	}
+
+	filePath := path.Join(dp.root, name)
+
+	info, err := fs.Stat(dp.fsys, filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+
+	if info.IsDir() {
+		return "", false, nil
+	}
+
+	contents, err := fs.ReadFile(dp.fsys, filePath)
+	if err != nil {
+		return "", false, err
+	}
+
+	return strings.TrimRight(string(contents), "\r\n"), true, nil
+}
+
+func (dp *DirectoryProvider) fileName(fieldPath []string) string {
+	separator := dp.Separator
+	if separator == "" {
+		separator = "."
+	}
+
+	name := strings.Join(fieldPath, separator)
+
+	switch dp.Case {
+	case KeyCaseUpper:
+		return strings.ToUpper(name)
+	case KeyCaseLower:
+		return strings.ToLower(name)
+	default:
+		return name
+	}
+}
@@ -0,0 +1,145 @@
+package providers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirectoryProvider_LookupValue(t *testing.T) {
+	fsys := fstest.MapFS{
+		"secrets/postgres.password": {Data: []byte("secret\n")},
+		"secrets/postgres.host":     {Data: []byte("localhost\r\n")},
+		"secrets/POSTGRES_USER":     {Data: []byte("admin")},
+		"secrets/empty":             {Data: []byte("")},
+		"secrets/multiline":         {Data: []byte("line1\nline2\n\n")},
+		"secrets/nested/key":        {Data: []byte("value")},
+	}
+
+	tests := []struct {
+		name      string
+		separator string
+		keyCase   KeyCase
+		fieldPath []string
+		expected  string
+		found     bool
+	}{
+		{
+			name:      "Trailing newline",
+			fieldPath: []string{"postgres", "password"},
+			expected:  "secret",
+			found:     true,
+		},
+		{
+			name:      "Trailing carriage return",
+			fieldPath: []string{"postgres", "host"},
+			expected:  "localhost",
+			found:     true,
+		},
+		{
+			name:      "Separator and case",
+			separator: "_",
+			keyCase:   KeyCaseUpper,
+			fieldPath: []string{"postgres", "user"},
+			expected:  "admin",
+			found:     true,
+		},
+		{
+			name:      "Lower case",
+			keyCase:   KeyCaseLower,
+			fieldPath: []string{"Postgres", "Password"},
+			expected:  "secret",
+			found:     true,
+		},
+		{
+			name:      "Empty file",
+			fieldPath: []string{"empty"},
+			expected:  "",
+			found:     true,
+		},
+		{
+			name:      "Only trailing newlines are trimmed",
+			fieldPath: []string{"multiline"},
+			expected:  "line1\nline2",
+			found:     true,
+		},
+		{
+			name:      "Missing file",
+			fieldPath: []string{"postgres", "port"},
+			expected:  "",
+			found:     false,
+		},
+		{
+			name:      "Directory",
+			fieldPath: []string{"nested"},
+			expected:  "",
+			found:     false,
+		},
+		{
+			name:      "Subdirectory separator",
+			separator: "/",
+			fieldPath: []string{"nested", "key"},
+			expected:  "value",
+			found:     true,
+		},
+		{
+			name:      "Path outside of the root",
+			separator: "/",
+			fieldPath: []string{"..", "secrets", "empty"},
+			expected:  "",
+			found:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dp, err := NewDirectoryProviderFromFs(fsys, "secrets")
+			assert.Nil(t, err)
+
+			dp.Separator = test.separator
+			dp.Case = test.keyCase
+
+			value, found, err := dp.LookupValue(test.fieldPath)
+			assert.Nil(t, err)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestDirectoryProvider_GetValue(t *testing.T) {
+	root := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(root, "postgres.password"), []byte("secret\n"), 0o600)
+	assert.Nil(t, err)
+
+	dp, err := NewDirectoryProvider(root)
+	assert.Nil(t, err)
+
+	value, err := dp.GetValue([]string{"postgres", "password"})
+	assert.Nil(t, err)
+	assert.Equal(t, "secret", value)
+
+	// Files are read on every lookup, so updates are picked up
+	err = os.WriteFile(filepath.Join(root, "postgres.password"), []byte("rotated\n"), 0o600)
+	assert.Nil(t, err)
+
+	value, err = dp.GetValue([]string{"postgres", "password"})
+	assert.Nil(t, err)
+	assert.Equal(t, "rotated", value)
+}
+
+func TestNewDirectoryProvider_Errors(t *testing.T) {
+	fsys := fstest.MapFS{
+		"file": {Data: []byte("value")},
+	}
+
+	_, err := NewDirectoryProviderFromFs(fsys, "missing")
+	assert.NotNil(t, err)
+
+	_, err = NewDirectoryProviderFromFs(fsys, "file")
+	assert.NotNil(t, err)
+}
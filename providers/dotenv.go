@@ -0,0 +1,174 @@
+package providers
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+// DotEnvProvider resolves values from a .env file, mapping the keys like EnvProvider does
+// (all uppercase and joined with '_'). The file is never loaded into the process environment
+type DotEnvProvider struct {
+	flatFile
+}
+
+func NewDotEnvProvider(filePath string) (*DotEnvProvider, error) {
+	return newDotEnvProvider(fileSource{path: filePath})
+}
+
+func NewDotEnvProviderFromFs(fs fs.FS, filePath string) (*DotEnvProvider, error) {
+	return newDotEnvProvider(fileSource{fsys: fs, path: filePath})
+}
+
+func newDotEnvProvider(source fileSource) (*DotEnvProvider, error) {
+	dp := &DotEnvProvider{}
+
+	err := dp.init(source, func(contents []byte) (map[string]string, error) {
+		return parseDotEnv(string(contents))
+	}, envKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return dp, nil
+}
+
+// parseDotEnv parses the contents of a .env file. It supports comments, the export prefix,
+// single quoted (literal) values, double quoted values with escapes which can span multiple lines
+// and ${VAR} interpolation in unquoted and double quoted values. Variables are interpolated from the
+// ones defined earlier in the file and then from the process environment
+func parseDotEnv(contents string) (map[string]string, error) {
+	data := map[string]string{}
+	lookup := func(key string) (string, bool) {
+		if value, ok := data[key]; ok {
+			return value, true
+		}
+
+		return os.LookupEnv(key)
+	}
+
+	lines := strings.Split(strings.ReplaceAll(contents, "\r\n", "\n"), "\n")
+
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimSpace(lines[i])
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line = strings.TrimPrefix(line, "export ")
+
+		key, rest, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" || strings.ContainsAny(key, " \t") {
+			return nil, fmt.Errorf("invalid .env line %d: expected KEY=VALUE", lineNumber)
+		}
+
+		rest = strings.TrimLeft(rest, " \t")
+
+		switch {
+		case strings.HasPrefix(rest, "'"):
+			end := strings.Index(rest[1:], "'")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid .env line %d: unterminated single quoted value", lineNumber)
+			}
+
+			data[key] = rest[1 : end+1]
+		case strings.HasPrefix(rest, `"`):
+			// Double quoted values can span multiple lines, so keep reading until the closing quote
+			value := rest[1:]
+			for {
+				end := closingQuote(value)
+				if end >= 0 {
+					value = value[:end]
+					break
+				}
+
+				i++
+				if i >= len(lines) {
+					return nil, fmt.Errorf("invalid .env line %d: unterminated double quoted value", lineNumber)
+				}
+
+				value += "\n" + lines[i]
+			}
+
+			data[key] = interpolate(value, lookup, true)
+		default:
+			// Unquoted values end at an inline comment
+			if index := strings.Index(rest, " #"); index >= 0 {
+				rest = rest[:index]
+			}
+
+			data[key] = interpolate(strings.TrimSpace(rest), lookup, false)
+		}
+	}
+
+	return data, nil
+}
+
+// closingQuote returns the index of the first double quote which is not escaped or -1 if there is none
+func closingQuote(value string) int {
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' {
+			i++
+		} else if value[i] == '"' {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// interpolate replaces ${VAR} and ${VAR:-default} with the value of the variable.
+// Dollar signs escaped with a backslash are kept as they are. If escapes is set (double quoted values), the escape
+// sequences (\n, \t, \r, \\, \" ...) are replaced in the same pass, so an escaped backslash before a dollar sign
+// does not escape the dollar sign
+func interpolate(value string, lookup func(string) (string, bool), escapes bool) string {
+	var builder strings.Builder
+
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) && (escapes || value[i+1] == '$') {
+			i++
+			builder.WriteByte(unescapeByte(value[i]))
+			continue
+		}
+
+		if value[i] != '$' || i+1 >= len(value) || value[i+1] != '{' {
+			builder.WriteByte(value[i])
+			continue
+		}
+
+		end := strings.IndexByte(value[i:], '}')
+		if end < 0 {
+			builder.WriteString(value[i:])
+			break
+		}
+
+		name, fallback, hasFallback := strings.Cut(value[i+2:i+end], ":-")
+		resolved, found := lookup(name)
+		if (!found || resolved == "") && hasFallback {
+			resolved = fallback
+		}
+
+		builder.WriteString(resolved)
+		i += end
+	}
+
+	return builder.String()
+}
+
+// unescapeByte returns the character of an escape sequence, e.g. a newline for \n
+func unescapeByte(escaped byte) byte {
+	switch escaped {
+	case 'n':
+		return '\n'
+	case 't':
+		return '\t'
+	case 'r':
+		return '\r'
+	default:
+		return escaped
+	}
+}
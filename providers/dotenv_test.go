@@ -0,0 +1,149 @@
+package providers
+
+import (
+	"embed"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//go:embed test.config.env
+var dotEnvConfig embed.FS
+
+func TestDotEnvProvider_LookupValue(t *testing.T) {
+	t.Setenv("FROM_PROCESS", "process")
+
+	dp, err := NewDotEnvProviderFromFs(dotEnvConfig, "test.config.env")
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name      string
+		fieldPath []string
+		expected  string
+		found     bool
+	}{
+		{
+			name:      "Unquoted value",
+			fieldPath: []string{"postgres", "host"},
+			expected:  "localhost",
+			found:     true,
+		},
+		{
+			name:      "Export prefix",
+			fieldPath: []string{"postgres.port"},
+			expected:  "5432",
+			found:     true,
+		},
+		{
+			name:      "Single quoted value",
+			fieldPath: []string{"postgres", "password"},
+			expected:  "pa$$word # not a comment",
+			found:     true,
+		},
+		{
+			name:      "Interpolation",
+			fieldPath: []string{"postgres", "url"},
+			expected:  "postgres://localhost:5432/app",
+			found:     true,
+		},
+		{
+			name:      "Escapes",
+			fieldPath: []string{"greeting"},
+			expected:  "hello\n\"world\"",
+			found:     true,
+		},
+		{
+			name:      "Multiline value",
+			fieldPath: []string{"certificate"},
+			expected:  "-----BEGIN-----\nabc\n-----END-----",
+			found:     true,
+		},
+		{
+			name:      "Inline comment",
+			fieldPath: []string{"log", "level"},
+			expected:  "debug",
+			found:     true,
+		},
+		{
+			name:      "Empty value",
+			fieldPath: []string{"empty"},
+			expected:  "",
+			found:     true,
+		},
+		{
+			name:      "Interpolation fallback",
+			fieldPath: []string{"fallback"},
+			expected:  "fallback",
+			found:     true,
+		},
+		{
+			name:      "Escaped dollar sign",
+			fieldPath: []string{"escaped"},
+			expected:  "${POSTGRES_HOST}",
+			found:     true,
+		},
+		{
+			name:      "Escaped backslash before interpolation",
+			fieldPath: []string{"escaped", "backslash"},
+			expected:  `\localhost`,
+			found:     true,
+		},
+		{
+			name:      "Missing key",
+			fieldPath: []string{"from", "process"},
+			expected:  "",
+			found:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, found, err := dp.LookupValue(test.fieldPath)
+			assert.Nil(t, err)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestDotEnvProvider_DoesNotMutateEnvironment(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "tests")
+	assert.Nil(t, err)
+
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.WriteString("DOTENV_ONLY=value\nFROM_PROCESS_COPY=${DOTENV_PROCESS}\n")
+	assert.Nil(t, err)
+
+	t.Setenv("DOTENV_PROCESS", "process")
+
+	dp, err := NewDotEnvProvider(tmpFile.Name())
+	assert.Nil(t, err)
+
+	value, err := dp.GetValue([]string{"from", "process", "copy"})
+	assert.Nil(t, err)
+	assert.Equal(t, "process", value)
+
+	_, found := os.LookupEnv("DOTENV_ONLY")
+	assert.False(t, found)
+}
+
+func TestParseDotEnv_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+	}{
+		{name: "Missing equals sign", contents: "KEY"},
+		{name: "Key with spaces", contents: "MY KEY=value"},
+		{name: "Unterminated single quote", contents: "KEY='value"},
+		{name: "Unterminated double quote", contents: "KEY=\"value\nOTHER=value"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseDotEnv(test.contents)
+			assert.NotNil(t, err)
+		})
+	}
+}
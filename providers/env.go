@@ -8,15 +8,25 @@ import (
 type EnvProvider struct{}
 
 func (ep EnvProvider) GetValue(fieldPath []string) (string, error) {
+	return os.Getenv(envKey(fieldPath)), nil
+}
+
+// LookupValue returns the value of the environment variable for the field and whether it is set,
+// so variables explicitly set to an empty string are considered found
+func (ep EnvProvider) LookupValue(fieldPath []string) (string, bool, error) {
+	value, found := os.LookupEnv(envKey(fieldPath))
+	return value, found, nil
+}
+
+// envKey maps a field path to an environment variable name (all uppercase and joined with '_')
+func envKey(fieldPath []string) string {
 	transformedPath := make([]string, len(fieldPath))
 	for i, path := range fieldPath {
 		transformedPath[i] = strings.ReplaceAll(path, ".", "_")
 	}
 
 	envVar := strings.Join(transformedPath, "_")
-	envVar = strings.ToUpper(envVar)
-
-	return os.Getenv(envVar), nil
+	return strings.ToUpper(envVar)
 }
 
 func NewEnvProvider() EnvProvider {
@@ -49,3 +49,24 @@ func TestEnvProvider_GetValue(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvProvider_LookupValue(t *testing.T) {
+	t.Setenv("TEST_EMPTY", "")
+	t.Setenv("TEST_SET", "value")
+
+	ep := NewEnvProvider()
+
+	value, found, err := ep.LookupValue([]string{"test", "empty"})
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "", value)
+
+	value, found, err = ep.LookupValue([]string{"test.set"})
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "value", value)
+
+	_, found, err = ep.LookupValue([]string{"test", "unset"})
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
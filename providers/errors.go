@@ -4,6 +4,9 @@ import "errors"
 
 var (
 	ErrInvalidVaultAuthConfig = errors.New("exactly one auth method options must be specified")
+	ErrInvalidVaultEngine     = errors.New("unknown secrets engine")
+	ErrInvalidVaultPath       = errors.New("at least one secret path must be specified and paths must not be empty")
+	ErrInvalidVaultVersion    = errors.New("secret versions must not be negative and are only supported by KV v2 engines")
 	ErrVaultConnection        = errors.New("error connecting to the Vault server")
 	ErrVaultAuth              = errors.New("error authenticating with Vault")
 	ErrVaultSecretFetch       = errors.New("error fetching secret from Vault")
@@ -0,0 +1,87 @@
+package providers
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"os"
+	"time"
+)
+
+// DefaultPollInterval is the interval at which watched files are checked for changes if not set in the provider
+const DefaultPollInterval = 5 * time.Second
+
+// fileSource is a config file either in the OS filesystem or in an fs.FS
+type fileSource struct {
+	fsys fs.FS
+	path string
+}
+
+func (fsrc fileSource) read() ([]byte, error) {
+	if fsrc.fsys == nil {
+		return os.ReadFile(fsrc.path)
+	}
+
+	return fs.ReadFile(fsrc.fsys, fsrc.path)
+}
+
+// watchFile polls the file at the given interval and calls reload with the new contents every time they differ from
+// the last ones, starting with the loaded contents (so changes made before watching are not missed).
+// The result of every reload is sent on the returned channel, which is closed when the context is done
+func watchFile(
+	ctx context.Context,
+	source fileSource,
+	interval time.Duration,
+	last []byte,
+	reload func([]byte) error,
+) <-chan error {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	changes := make(chan error)
+
+	go func() {
+		defer close(changes)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		readFailed := false
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			contents, err := source.read()
+			if err != nil {
+				// Only report the first of consecutive read failures (e.g. while the file is being replaced)
+				if readFailed {
+					continue
+				}
+
+				readFailed = true
+			} else {
+				readFailed = false
+
+				if bytes.Equal(contents, last) {
+					continue
+				}
+
+				last = contents
+				err = reload(contents)
+			}
+
+			select {
+			case changes <- err:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return changes
+}
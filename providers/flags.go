@@ -0,0 +1,143 @@
+package providers
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// FlagsProvider resolves values from command line flags. The flags are derived from the prop paths of the config
+// struct (e.g. --postgres.host) and only the flags set by the user are reported, so they override every other
+// provider registered before it
+type FlagsProvider struct {
+	flags map[string]*flagValue
+}
+
+// NewFlagsProvider registers a flag for every field of the given config struct on the flag set.
+// The default and usage tags of the fields are used for the default value and the help text of the flags.
+// The flag set must be parsed before populating the config
+func NewFlagsProvider(flagSet *flag.FlagSet, cfg interface{}) (*FlagsProvider, error) {
+	t := reflect.TypeOf(cfg)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, errors.New("the config must be a struct or a pointer to a struct")
+	}
+
+	fp := &FlagsProvider{flags: map[string]*flagValue{}}
+
+	err := fp.registerFields(flagSet, t, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return fp, nil
+}
+
+// registerFields registers the flags of the fields of a struct following the same rules Gofig uses to populate it
+func (fp *FlagsProvider) registerFields(flagSet *flag.FlagSet, t reflect.Type, parentPath []string) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if !field.IsExported() && !(field.Anonymous && field.Type.Kind() == reflect.Struct) {
+			continue
+		}
+
+		path := append([]string{}, parentPath...)
+		if prop := field.Tag.Get("prop"); !field.Anonymous || prop != "" {
+			path = append(path, strings.Split(prop, ".")...)
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		if fieldType.Kind() == reflect.Struct && fieldType != timeType {
+			err := fp.registerFields(flagSet, fieldType, path)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		name := strings.Join(path, ".")
+		if flagSet.Lookup(name) != nil {
+			return fmt.Errorf("flag %s for field %s is already defined", name, field.Name)
+		}
+
+		value := &flagValue{
+			value:  field.Tag.Get("default"),
+			isBool: fieldType.Kind() == reflect.Bool,
+			isList: fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Map,
+			sep:    field.Tag.Get("sep"),
+		}
+
+		flagSet.Var(value, name, field.Tag.Get("usage"))
+		fp.flags[name] = value
+	}
+
+	return nil
+}
+
+func (fp *FlagsProvider) GetValue(fieldPath []string) (string, error) {
+	value, _, err := fp.LookupValue(fieldPath)
+	return value, err
+}
+
+// LookupValue returns the value of the flag for the field if it was set by the user
+func (fp *FlagsProvider) LookupValue(fieldPath []string) (string, bool, error) {
+	value, ok := fp.flags[strings.Join(fieldPath, ".")]
+	if !ok || !value.set {
+		return "", false, nil
+	}
+
+	return value.value, true, nil
+}
+
+// flagValue is a flag.Value which keeps track of whether the flag was set.
+// Flags of slice and map fields can be repeated, in which case the values are joined with the separator of the field
+type flagValue struct {
+	value  string
+	set    bool
+	isBool bool
+	isList bool
+	sep    string
+}
+
+func (fv *flagValue) String() string {
+	if fv == nil {
+		return ""
+	}
+
+	return fv.value
+}
+
+func (fv *flagValue) Set(value string) error {
+	if fv.isList && fv.set {
+		sep := fv.sep
+		if sep == "" {
+			sep = ","
+		}
+
+		fv.value += sep + value
+	} else {
+		fv.value = value
+	}
+
+	fv.set = true
+	return nil
+}
+
+// IsBoolFlag allows setting the flags of bool fields without a value (e.g. --debug)
+func (fv *flagValue) IsBoolFlag() bool {
+	return fv.isBool
+}
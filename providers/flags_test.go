@@ -0,0 +1,120 @@
+package providers
+
+import (
+	"flag"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type flagsPgConfig struct {
+	Host string `prop:"host" default:"localhost" usage:"The Postgres host"`
+	Port int    `prop:"port" default:"5432"`
+}
+
+type flagsCommon struct {
+	LogLevel string `prop:"log.level" usage:"The log level"`
+}
+
+type flagsConfig struct {
+	flagsCommon
+	Debug    bool           `prop:"debug"`
+	Hosts    []string       `prop:"hosts"`
+	Postgres *flagsPgConfig `prop:"postgres"`
+}
+
+func TestNewFlagsProvider_RegistersFlags(t *testing.T) {
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+
+	_, err := NewFlagsProvider(flagSet, new(flagsConfig))
+	assert.Nil(t, err)
+
+	names := make([]string, 0)
+	flagSet.VisitAll(func(f *flag.Flag) {
+		names = append(names, f.Name)
+	})
+
+	assert.ElementsMatch(t, []string{"log.level", "debug", "hosts", "postgres.host", "postgres.port"}, names)
+
+	host := flagSet.Lookup("postgres.host")
+	assert.Equal(t, "localhost", host.DefValue)
+	assert.Equal(t, "The Postgres host", host.Usage)
+}
+
+func TestFlagsProvider_LookupValue(t *testing.T) {
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+
+	fp, err := NewFlagsProvider(flagSet, new(flagsConfig))
+	assert.Nil(t, err)
+
+	err = flagSet.Parse([]string{"--postgres.host", "db", "--debug", "--hosts=a", "--hosts=b", "--log.level="})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name      string
+		fieldPath []string
+		expected  string
+		found     bool
+	}{
+		{
+			name:      "Set flag",
+			fieldPath: []string{"postgres", "host"},
+			expected:  "db",
+			found:     true,
+		},
+		{
+			name:      "Bool flag without value",
+			fieldPath: []string{"debug"},
+			expected:  "true",
+			found:     true,
+		},
+		{
+			name:      "Repeated list flag",
+			fieldPath: []string{"hosts"},
+			expected:  "a,b",
+			found:     true,
+		},
+		{
+			name:      "Flag set to an empty value",
+			fieldPath: []string{"log", "level"},
+			expected:  "",
+			found:     true,
+		},
+		{
+			name:      "Flag not set",
+			fieldPath: []string{"postgres", "port"},
+			expected:  "",
+			found:     false,
+		},
+		{
+			name:      "Unknown flag",
+			fieldPath: []string{"unknown"},
+			expected:  "",
+			found:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, found, err := fp.LookupValue(test.fieldPath)
+			assert.Nil(t, err)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestNewFlagsProvider_Errors(t *testing.T) {
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+	flagSet.String("debug", "", "")
+
+	_, err := NewFlagsProvider(flagSet, new(flagsConfig))
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "debug"))
+
+	_, err = NewFlagsProvider(flag.NewFlagSet("test", flag.ContinueOnError), "not a struct")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,83 @@
+package providers
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// flatFile is a config file parsed into flat key/value pairs, which resolves field paths by mapping them to keys.
+// It implements the provider methods shared by the key/value file formats (.env, INI, ...)
+type flatFile struct {
+	// PollInterval is the interval at which the file is checked for changes when watched (default DefaultPollInterval)
+	PollInterval time.Duration
+
+	source fileSource
+	parse  func(contents []byte) (map[string]string, error)
+	key    func(fieldPath []string) string
+	mu     sync.RWMutex
+	data   map[string]string
+
+	// contents are the loaded contents of the file, which watching compares the polled ones with
+	contents []byte
+}
+
+// init sets the source, parser and key mapping of the file and loads it
+func (ff *flatFile) init(
+	source fileSource,
+	parse func([]byte) (map[string]string, error),
+	key func([]string) string,
+) error {
+	ff.source = source
+	ff.parse = parse
+	ff.key = key
+
+	contents, err := source.read()
+	if err != nil {
+		return err
+	}
+
+	return ff.load(contents)
+}
+
+func (ff *flatFile) load(contents []byte) error {
+	data, err := ff.parse(contents)
+	if err != nil {
+		return err
+	}
+
+	ff.mu.Lock()
+	defer ff.mu.Unlock()
+
+	ff.data = data
+	ff.contents = contents
+	return nil
+}
+
+// Watch polls the file for changes and reloads it every time its contents change
+func (ff *flatFile) Watch(ctx context.Context) (<-chan error, error) {
+	ff.mu.RLock()
+	contents := ff.contents
+	ff.mu.RUnlock()
+
+	return watchFile(ctx, ff.source, ff.PollInterval, contents, ff.load), nil
+}
+
+func (ff *flatFile) GetValue(fieldPath []string) (string, error) {
+	value, _, err := ff.LookupValue(fieldPath)
+	return value, err
+}
+
+func (ff *flatFile) LookupValue(fieldPath []string) (string, bool, error) {
+	ff.mu.RLock()
+	defer ff.mu.RUnlock()
+
+	value, found := ff.data[ff.key(fieldPath)]
+	return value, found, nil
+}
+
+// dottedKey maps a field path to a key by joining its parts with '.' (e.g. postgres.host)
+func dottedKey(fieldPath []string) string {
+	return strings.Join(fieldPath, ".")
+}
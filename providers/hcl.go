@@ -0,0 +1,153 @@
+package providers
+
+import (
+	"fmt"
+	"io/fs"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// HCLProvider resolves values from an HCL file. Blocks are resolved like nested objects, with every label
+// adding a level, so the prop postgres.primary.host resolves the host attribute of a postgres "primary" block.
+// Attributes must be literal values, since there are no variables or functions to evaluate expressions with
+type HCLProvider struct {
+	fileTree
+}
+
+func NewHCLProvider(filePath string) (*HCLProvider, error) {
+	return newHCLProvider(fileSource{path: filePath})
+}
+
+func NewHCLProviderFromFs(fs fs.FS, filePath string) (*HCLProvider, error) {
+	return newHCLProvider(fileSource{fsys: fs, path: filePath})
+}
+
+func newHCLProvider(source fileSource) (*HCLProvider, error) {
+	hp := &HCLProvider{}
+
+	err := hp.init(source, func(contents []byte) (map[string]interface{}, error) {
+		return parseHCL(contents, source.path)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hp, nil
+}
+
+func parseHCL(contents []byte, filename string) (map[string]interface{}, error) {
+	file, diags := hclsyntax.ParseConfig(contents, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	parsed := map[string]interface{}{}
+
+	err := decodeHCLBody(file.Body.(*hclsyntax.Body), parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsed, nil
+}
+
+// decodeHCLBody adds the attributes and blocks of an HCL body to the given map.
+// Blocks of the same type (and labels) are merged into the same object
+func decodeHCLBody(body *hclsyntax.Body, target map[string]interface{}) error {
+	for name, attribute := range body.Attributes {
+		value, diags := attribute.Expr.Value(nil)
+		if diags.HasErrors() {
+			return diags
+		}
+
+		decoded, err := decodeCtyValue(value)
+		if err != nil {
+			return fmt.Errorf("attribute %s: %w", name, err)
+		}
+
+		target[name] = decoded
+	}
+
+	for _, block := range body.Blocks {
+		current := target
+
+		for _, key := range append([]string{block.Type}, block.Labels...) {
+			child, ok := current[key].(map[string]interface{})
+			if !ok {
+				if _, exists := current[key]; exists {
+					return fmt.Errorf("block %s conflicts with the attribute %s", block.Type, key)
+				}
+
+				child = map[string]interface{}{}
+				current[key] = child
+			}
+
+			current = child
+		}
+
+		err := decodeHCLBody(block.Body, current)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeCtyValue converts an evaluated HCL value into the types the JSON provider returns:
+// strings, float64 numbers, bools, []interface{} and map[string]interface{}
+func decodeCtyValue(value cty.Value) (interface{}, error) {
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	if !value.IsWhollyKnown() {
+		return nil, fmt.Errorf("unknown value")
+	}
+
+	valueType := value.Type()
+
+	switch {
+	case valueType == cty.String:
+		return value.AsString(), nil
+	case valueType == cty.Number:
+		number, _ := value.AsBigFloat().Float64()
+		return number, nil
+	case valueType == cty.Bool:
+		return value.True(), nil
+	case valueType.IsListType() || valueType.IsTupleType() || valueType.IsSetType():
+		items := make([]interface{}, 0, value.LengthInt())
+
+		for it := value.ElementIterator(); it.Next(); {
+			_, item := it.Element()
+
+			decoded, err := decodeCtyValue(item)
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, decoded)
+		}
+
+		return items, nil
+	case valueType.IsMapType() || valueType.IsObjectType():
+		object := map[string]interface{}{}
+
+		for it := value.ElementIterator(); it.Next(); {
+			key, item := it.Element()
+
+			decoded, err := decodeCtyValue(item)
+			if err != nil {
+				return nil, err
+			}
+
+			object[key.AsString()] = decoded
+		}
+
+		return object, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", valueType.FriendlyName())
+	}
+}
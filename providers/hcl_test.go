@@ -0,0 +1,114 @@
+package providers
+
+import (
+	"embed"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//go:embed test.config.hcl
+var hclConfig embed.FS
+
+func TestHCLProvider_GetRawValue(t *testing.T) {
+	hp, err := NewHCLProviderFromFs(hclConfig, "test.config.hcl")
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name      string
+		fieldPath []string
+		expected  interface{}
+	}{
+		{
+			name:      "String attribute",
+			fieldPath: []string{"name"},
+			expected:  "app",
+		},
+		{
+			name:      "Bool attribute",
+			fieldPath: []string{"debug"},
+			expected:  true,
+		},
+		{
+			name:      "Number attribute",
+			fieldPath: []string{"replicas"},
+			expected:  float64(3),
+		},
+		{
+			name:      "List attribute",
+			fieldPath: []string{"list"},
+			expected:  []interface{}{"a", "b"},
+		},
+		{
+			name:      "Object attribute",
+			fieldPath: []string{"labels", "team"},
+			expected:  "platform",
+		},
+		{
+			name:      "Block attribute",
+			fieldPath: []string{"postgres", "host"},
+			expected:  "localhost",
+		},
+		{
+			name:      "Labeled block attribute",
+			fieldPath: []string{"service", "worker", "port"},
+			expected:  float64(9090),
+		},
+		{
+			name:      "Invalid key",
+			fieldPath: []string{"postgres", "user"},
+			expected:  nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := hp.GetRawValue(test.fieldPath)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestHCLProvider_GetValue(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "tests")
+	assert.Nil(t, err)
+
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.WriteString("postgres {\n  host = \"db\"\n  port = 5432\n}\n")
+	assert.Nil(t, err)
+
+	hp, err := NewHCLProvider(tmpFile.Name())
+	assert.Nil(t, err)
+
+	value, err := hp.GetValue([]string{"postgres", "host"})
+	assert.Nil(t, err)
+	assert.Equal(t, "db", value)
+
+	value, err = hp.GetValue([]string{"postgres", "user"})
+	assert.Nil(t, err)
+	assert.Equal(t, "", value)
+
+	_, err = hp.GetValue([]string{"postgres", "port"})
+	assert.NotNil(t, err)
+}
+
+func TestParseHCL_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+	}{
+		{name: "Syntax error", contents: "postgres {"},
+		{name: "Variable reference", contents: "host = var.host"},
+		{name: "Block conflicting with an attribute", contents: "postgres = \"db\"\npostgres {\n  host = \"db\"\n}\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseHCL([]byte(test.contents), "test.hcl")
+			assert.NotNil(t, err)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package providers
+
+import (
+	"fmt"
+	"io/fs"
+	"strings"
+)
+
+// INIProvider resolves values from an INI file. Keys are resolved as section.key, so the prop postgres.host
+// resolves the host key of the [postgres] section. Keys before the first section are resolved by their name
+type INIProvider struct {
+	flatFile
+}
+
+func NewINIProvider(filePath string) (*INIProvider, error) {
+	return newINIProvider(fileSource{path: filePath})
+}
+
+func NewINIProviderFromFs(fs fs.FS, filePath string) (*INIProvider, error) {
+	return newINIProvider(fileSource{fsys: fs, path: filePath})
+}
+
+func newINIProvider(source fileSource) (*INIProvider, error) {
+	ip := &INIProvider{}
+
+	err := ip.init(source, func(contents []byte) (map[string]string, error) {
+		return parseINI(string(contents))
+	}, dottedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return ip, nil
+}
+
+// parseINI parses the contents of an INI file into keys prefixed by their section (e.g. postgres.host).
+// It supports ; and # comments, key = value and key: value pairs and quoted values
+func parseINI(contents string) (map[string]string, error) {
+	data := map[string]string{}
+	section := ""
+
+	lines := strings.Split(strings.ReplaceAll(contents, "\r\n", "\n"), "\n")
+
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+
+		if line == "" || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("invalid INI line %d: unterminated section", i+1)
+			}
+
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+
+		separator := strings.IndexAny(line, "=:")
+		if separator < 0 {
+			return nil, fmt.Errorf("invalid INI line %d: expected key = value", i+1)
+		}
+
+		key := strings.TrimSpace(line[:separator])
+		if key == "" {
+			return nil, fmt.Errorf("invalid INI line %d: empty key", i+1)
+		}
+
+		if section != "" {
+			key = section + "." + key
+		}
+
+		data[key] = unquote(strings.TrimSpace(line[separator+1:]))
+	}
+
+	return data, nil
+}
+
+// unquote removes the matching single or double quotes around a value
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
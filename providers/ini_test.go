@@ -0,0 +1,93 @@
+package providers
+
+import (
+	"embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//go:embed test.config.ini
+var iniConfig embed.FS
+
+func TestINIProvider_LookupValue(t *testing.T) {
+	ip, err := NewINIProviderFromFs(iniConfig, "test.config.ini")
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name      string
+		fieldPath []string
+		expected  string
+		found     bool
+	}{
+		{
+			name:      "Key before any section",
+			fieldPath: []string{"name"},
+			expected:  "app",
+			found:     true,
+		},
+		{
+			name:      "Section key",
+			fieldPath: []string{"postgres", "host"},
+			expected:  "localhost",
+			found:     true,
+		},
+		{
+			name:      "Colon separator",
+			fieldPath: []string{"postgres", "port"},
+			expected:  "5432",
+			found:     true,
+		},
+		{
+			name:      "Quoted value",
+			fieldPath: []string{"postgres", "password"},
+			expected:  "pa;ss word",
+			found:     true,
+		},
+		{
+			name:      "Empty value",
+			fieldPath: []string{"postgres", "empty"},
+			expected:  "",
+			found:     true,
+		},
+		{
+			name:      "Dotted section",
+			fieldPath: []string{"postgres", "pool", "size"},
+			expected:  "10",
+			found:     true,
+		},
+		{
+			name:      "Missing key",
+			fieldPath: []string{"postgres", "user"},
+			expected:  "",
+			found:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, found, err := ip.LookupValue(test.fieldPath)
+			assert.Nil(t, err)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestParseINI_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+	}{
+		{name: "Missing separator", contents: "key"},
+		{name: "Empty key", contents: "= value"},
+		{name: "Unterminated section", contents: "[postgres"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseINI(test.contents)
+			assert.NotNil(t, err)
+		})
+	}
+}
@@ -1,79 +1,41 @@
 package providers
 
 import (
-	"errors"
-	"fmt"
-	"io"
 	"io/fs"
-	"os"
 
 	json "github.com/titanous/json5"
 )
 
 type JSONProvider struct {
-	parsedFile map[string]interface{}
+	fileTree
 }
 
 func NewJSONProvider(filePath string) (*JSONProvider, error) {
-	contents, err := os.ReadFile(filePath)
-	if err != nil {
-		return nil, err
-	}
-
-	parsed := map[string]interface{}{}
-
-	err = json.Unmarshal(contents, &parsed)
-	if err != nil {
-		return nil, err
-	}
-
-	return &JSONProvider{parsedFile: parsed}, nil
+	return newJSONProvider(fileSource{path: filePath})
 }
 
 func NewJSONProviderFromFs(fs fs.FS, filePath string) (*JSONProvider, error) {
-	file, err := fs.Open(filePath)
-	if err != nil {
-		return nil, err
-	}
-
-	contents, err := io.ReadAll(file)
-	if err != nil {
-		return nil, err
-	}
+	return newJSONProvider(fileSource{fsys: fs, path: filePath})
+}
 
-	parsed := map[string]interface{}{}
+func newJSONProvider(source fileSource) (*JSONProvider, error) {
+	jp := &JSONProvider{}
 
-	err = json.Unmarshal(contents, &parsed)
+	err := jp.init(source, parseJSON)
 	if err != nil {
 		return nil, err
 	}
 
-	return &JSONProvider{parsedFile: parsed}, nil
+	return jp, nil
 }
 
-func (jp JSONProvider) GetValue(fieldPath []string) (string, error) {
-	var currentValue interface{} = jp.parsedFile
-
-	for _, path := range fieldPath {
-		m, ok := currentValue.(map[string]interface{})
-		if !ok {
-			return "", nil
-		}
-
-		currentValue, ok = m[path]
-		if !ok {
-			break
-		}
-	}
-
-	if currentValue == nil {
-		return "", nil
-	}
+func parseJSON(contents []byte) (map[string]interface{}, error) {
+	parsed := map[string]interface{}{}
 
-	strValue, ok := currentValue.(string)
-	if !ok {
-		return "", errors.New(fmt.Sprintf("got invalid value: %+v", currentValue))
+	err := json.Unmarshal(contents, &parsed)
+	if err != nil {
+		return nil, err
 	}
 
-	return strValue, nil
+	return parsed, nil
 }
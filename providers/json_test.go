@@ -1,11 +1,14 @@
 package providers
 
 import (
+	"context"
 	"embed"
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -98,3 +101,123 @@ func TestNewJSONProviderFromFs(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "value", value)
 }
+
+func TestJSONProvider_GetRawValue(t *testing.T) {
+	jp, err := NewJSONProviderFromFs(config, "test.config.json5")
+	assert.Nil(t, err)
+
+	value, err := jp.GetRawValue([]string{"nested"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"key": "value"}, value)
+
+	value, err = jp.GetRawValue([]string{"list"})
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"a", "b"}, value)
+
+	value, err = jp.GetRawValue([]string{"nonexistent"})
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+}
+
+func TestJSONProvider_LookupValue(t *testing.T) {
+	jp, err := NewJSONProviderFromFs(config, "test.config.json5")
+	assert.Nil(t, err)
+
+	value, found, err := jp.LookupValue([]string{"empty"})
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "", value)
+
+	_, found, err = jp.LookupValue([]string{"nonexistent"})
+	assert.Nil(t, err)
+	assert.False(t, found)
+}
+
+func TestJSONProvider_Watch(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "tests")
+	assert.Nil(t, err)
+
+	defer os.Remove(tmpFile.Name())
+
+	err = os.WriteFile(tmpFile.Name(), []byte(`{"key": "old"}`), 0644)
+	assert.Nil(t, err)
+
+	jp, err := NewJSONProvider(tmpFile.Name())
+	assert.Nil(t, err)
+
+	jp.PollInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes, err := jp.Watch(ctx)
+	assert.Nil(t, err)
+
+	err = os.WriteFile(tmpFile.Name(), []byte(`{"key": "new"}`), 0644)
+	assert.Nil(t, err)
+
+	select {
+	case err := <-changes:
+		assert.Nil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("no change received")
+	}
+
+	value, err := jp.GetValue([]string{"key"})
+	assert.Nil(t, err)
+	assert.Equal(t, "new", value)
+
+	err = os.WriteFile(tmpFile.Name(), []byte(`{"key": `), 0644)
+	assert.Nil(t, err)
+
+	select {
+	case err := <-changes:
+		assert.NotNil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("no error received")
+	}
+
+	value, err = jp.GetValue([]string{"key"})
+	assert.Nil(t, err)
+	assert.Equal(t, "new", value)
+
+	cancel()
+
+	_, open := <-changes
+	assert.False(t, open)
+}
+
+func TestJSONProvider_WatchChangeBeforeWatching(t *testing.T) {
+	// GIVEN
+	path := filepath.Join(t.TempDir(), "config.json")
+
+	err := os.WriteFile(path, []byte(`{"key": "old"}`), 0644)
+	assert.Nil(t, err)
+
+	jp, err := NewJSONProvider(path)
+	assert.Nil(t, err)
+
+	jp.PollInterval = 10 * time.Millisecond
+
+	err = os.WriteFile(path, []byte(`{"key": "new"}`), 0644)
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// WHEN
+	changes, err := jp.Watch(ctx)
+	assert.Nil(t, err)
+
+	// THEN
+	select {
+	case err := <-changes:
+		assert.Nil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("no change received")
+	}
+
+	value, err := jp.GetValue([]string{"key"})
+	assert.Nil(t, err)
+	assert.Equal(t, "new", value)
+}
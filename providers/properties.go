@@ -0,0 +1,144 @@
+package providers
+
+import (
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// PropertiesProvider resolves values from a Java .properties file. Keys are resolved by joining the prop path
+// with '.', so the prop postgres.host resolves the postgres.host key
+type PropertiesProvider struct {
+	flatFile
+}
+
+func NewPropertiesProvider(filePath string) (*PropertiesProvider, error) {
+	return newPropertiesProvider(fileSource{path: filePath})
+}
+
+func NewPropertiesProviderFromFs(fs fs.FS, filePath string) (*PropertiesProvider, error) {
+	return newPropertiesProvider(fileSource{fsys: fs, path: filePath})
+}
+
+func newPropertiesProvider(source fileSource) (*PropertiesProvider, error) {
+	pp := &PropertiesProvider{}
+
+	err := pp.init(source, func(contents []byte) (map[string]string, error) {
+		return parseProperties(string(contents))
+	}, dottedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return pp, nil
+}
+
+// parseProperties parses the contents of a .properties file following the format of java.util.Properties:
+// # and ! comments, =, : or whitespace separators, lines continued with a trailing backslash and escapes
+// (including \uXXXX) in keys and values
+func parseProperties(contents string) (map[string]string, error) {
+	data := map[string]string{}
+
+	lines := strings.Split(strings.ReplaceAll(contents, "\r\n", "\n"), "\n")
+
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimLeft(lines[i], " \t\f")
+
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+
+		// Join the lines ending with an odd number of backslashes with the next one
+		for endsWithContinuation(line) && i+1 < len(lines) {
+			i++
+			line = line[:len(line)-1] + strings.TrimLeft(lines[i], " \t\f")
+		}
+
+		key, value := splitProperty(line)
+
+		unescapedKey, err := unescapeProperty(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid properties line %d: %w", lineNumber, err)
+		}
+
+		unescapedValue, err := unescapeProperty(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid properties line %d: %w", lineNumber, err)
+		}
+
+		data[unescapedKey] = unescapedValue
+	}
+
+	return data, nil
+}
+
+func endsWithContinuation(line string) bool {
+	backslashes := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		backslashes++
+	}
+
+	return backslashes%2 == 1
+}
+
+// splitProperty splits a line on the first unescaped =, : or whitespace
+func splitProperty(line string) (string, string) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '=', ':':
+			return line[:i], strings.TrimLeft(line[i+1:], " \t\f")
+		case ' ', '\t', '\f':
+			rest := strings.TrimLeft(line[i:], " \t\f")
+			if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+				rest = strings.TrimLeft(rest[1:], " \t\f")
+			}
+
+			return line[:i], rest
+		}
+	}
+
+	return line, ""
+}
+
+func unescapeProperty(value string) (string, error) {
+	var builder strings.Builder
+
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' || i == len(value)-1 {
+			builder.WriteByte(value[i])
+			continue
+		}
+
+		i++
+		switch value[i] {
+		case 't':
+			builder.WriteByte('\t')
+		case 'n':
+			builder.WriteByte('\n')
+		case 'r':
+			builder.WriteByte('\r')
+		case 'f':
+			builder.WriteByte('\f')
+		case 'u':
+			if i+4 >= len(value) {
+				return "", fmt.Errorf("invalid unicode escape")
+			}
+
+			code, err := strconv.ParseUint(value[i+1:i+5], 16, 32)
+			if err != nil {
+				return "", fmt.Errorf("invalid unicode escape: %w", err)
+			}
+
+			builder.WriteRune(rune(code))
+			i += 4
+		default:
+			builder.WriteByte(value[i])
+		}
+	}
+
+	return builder.String(), nil
+}
@@ -0,0 +1,104 @@
+package providers
+
+import (
+	"embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//go:embed test.config.properties
+var propertiesConfig embed.FS
+
+func TestPropertiesProvider_LookupValue(t *testing.T) {
+	pp, err := NewPropertiesProviderFromFs(propertiesConfig, "test.config.properties")
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name      string
+		fieldPath []string
+		expected  string
+		found     bool
+	}{
+		{
+			name:      "Equals separator",
+			fieldPath: []string{"postgres", "host"},
+			expected:  "localhost",
+			found:     true,
+		},
+		{
+			name:      "Colon separator",
+			fieldPath: []string{"postgres", "port"},
+			expected:  "5432",
+			found:     true,
+		},
+		{
+			name:      "Whitespace separator",
+			fieldPath: []string{"postgres", "user"},
+			expected:  "admin",
+			found:     true,
+		},
+		{
+			name:      "Line continuation",
+			fieldPath: []string{"postgres", "url"},
+			expected:  "postgres://localhost:5432/app",
+			found:     true,
+		},
+		{
+			name:      "Escapes",
+			fieldPath: []string{"greeting"},
+			expected:  "hello\nworld",
+			found:     true,
+		},
+		{
+			name:      "Unicode escape",
+			fieldPath: []string{"unicode"},
+			expected:  "café",
+			found:     true,
+		},
+		{
+			name:      "Escaped key",
+			fieldPath: []string{"key with spaces"},
+			expected:  "value",
+			found:     true,
+		},
+		{
+			name:      "Empty value",
+			fieldPath: []string{"empty"},
+			expected:  "",
+			found:     true,
+		},
+		{
+			name:      "Missing key",
+			fieldPath: []string{"postgres", "password"},
+			expected:  "",
+			found:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, found, err := pp.LookupValue(test.fieldPath)
+			assert.Nil(t, err)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestParseProperties_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+	}{
+		{name: "Short unicode escape", contents: `key=\u00`},
+		{name: "Invalid unicode escape", contents: `key=\uzzzz`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseProperties(test.contents)
+			assert.NotNil(t, err)
+		})
+	}
+}
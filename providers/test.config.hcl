@@ -0,0 +1,19 @@
+# Local development settings
+name     = "app"
+debug    = true
+replicas = 3
+list     = ["a", "b"]
+labels   = { team = "platform" }
+
+postgres {
+  host = "localhost"
+  port = 5432
+}
+
+service "web" {
+  port = 8080
+}
+
+service "worker" {
+  port = 9090
+}
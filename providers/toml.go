@@ -0,0 +1,69 @@
+package providers
+
+import (
+	"io/fs"
+
+	"github.com/BurntSushi/toml"
+)
+
+type TOMLProvider struct {
+	fileTree
+}
+
+func NewTOMLProvider(filePath string) (*TOMLProvider, error) {
+	return newTOMLProvider(fileSource{path: filePath})
+}
+
+func NewTOMLProviderFromFs(fs fs.FS, filePath string) (*TOMLProvider, error) {
+	return newTOMLProvider(fileSource{fsys: fs, path: filePath})
+}
+
+func newTOMLProvider(source fileSource) (*TOMLProvider, error) {
+	tp := &TOMLProvider{}
+
+	err := tp.init(source, parseTOML)
+	if err != nil {
+		return nil, err
+	}
+
+	return tp, nil
+}
+
+func parseTOML(contents []byte) (map[string]interface{}, error) {
+	parsed := map[string]interface{}{}
+
+	err := toml.Unmarshal(contents, &parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	return normalizeTOML(parsed).(map[string]interface{}), nil
+}
+
+// normalizeTOML converts the arrays of tables that the TOML decoder returns as []map[string]interface{}
+// into []interface{}, so they are returned like any other array
+func normalizeTOML(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, item := range typed {
+			typed[key] = normalizeTOML(item)
+		}
+
+		return typed
+	case []map[string]interface{}:
+		normalized := make([]interface{}, len(typed))
+		for i, item := range typed {
+			normalized[i] = normalizeTOML(item)
+		}
+
+		return normalized
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = normalizeTOML(item)
+		}
+
+		return typed
+	default:
+		return value
+	}
+}
@@ -0,0 +1,102 @@
+package providers
+
+import (
+	"embed"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//go:embed test.config.toml
+var tomlConfig embed.FS
+
+func TestTOMLProvider_GetRawValue(t *testing.T) {
+	tp, err := NewTOMLProviderFromFs(tomlConfig, "test.config.toml")
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name      string
+		fieldPath []string
+		expected  interface{}
+	}{
+		{
+			name:      "String value",
+			fieldPath: []string{"some"},
+			expected:  "value",
+		},
+		{
+			name:      "Nested key",
+			fieldPath: []string{"nested", "key"},
+			expected:  "value",
+		},
+		{
+			name:      "Deeply nested int",
+			fieldPath: []string{"nested", "deeper", "port"},
+			expected:  int64(5432),
+		},
+		{
+			name:      "Array",
+			fieldPath: []string{"ports"},
+			expected:  []interface{}{int64(80), int64(443)},
+		},
+		{
+			name:      "Array of tables",
+			fieldPath: []string{"servers"},
+			expected:  []interface{}{map[string]interface{}{"name": "alpha"}},
+		},
+		{
+			name:      "Bool",
+			fieldPath: []string{"enabled"},
+			expected:  true,
+		},
+		{
+			name:      "Float",
+			fieldPath: []string{"ratio"},
+			expected:  0.5,
+		},
+		{
+			name:      "Datetime",
+			fieldPath: []string{"created"},
+			expected:  time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "Invalid key",
+			fieldPath: []string{"nonexistent"},
+			expected:  nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := tp.GetRawValue(test.fieldPath)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestTOMLProvider_GetValue(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "tests")
+	assert.Nil(t, err)
+
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.WriteString("[postgres]\nhost = \"db\"\nport = 5432\n")
+	assert.Nil(t, err)
+
+	tp, err := NewTOMLProvider(tmpFile.Name())
+	assert.Nil(t, err)
+
+	value, err := tp.GetValue([]string{"postgres", "host"})
+	assert.Nil(t, err)
+	assert.Equal(t, "db", value)
+
+	value, err = tp.GetValue([]string{"postgres", "user"})
+	assert.Nil(t, err)
+	assert.Equal(t, "", value)
+
+	_, err = tp.GetValue([]string{"postgres", "port"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,102 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// fileTree is a config file parsed into a tree of maps, which resolves field paths by walking the tree.
+// It implements the provider methods shared by the structured file formats (JSON, YAML, ...)
+type fileTree struct {
+	// PollInterval is the interval at which the file is checked for changes when watched (default DefaultPollInterval)
+	PollInterval time.Duration
+
+	source fileSource
+	parse  func(contents []byte) (map[string]interface{}, error)
+	mu     sync.RWMutex
+	tree   map[string]interface{}
+
+	// contents are the loaded contents of the file, which watching compares the polled ones with
+	contents []byte
+}
+
+// init sets the source and parser of the tree and loads the file
+func (ft *fileTree) init(source fileSource, parse func([]byte) (map[string]interface{}, error)) error {
+	ft.source = source
+	ft.parse = parse
+
+	contents, err := source.read()
+	if err != nil {
+		return err
+	}
+
+	return ft.load(contents)
+}
+
+func (ft *fileTree) load(contents []byte) error {
+	parsed, err := ft.parse(contents)
+	if err != nil {
+		return err
+	}
+
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+
+	ft.tree = parsed
+	ft.contents = contents
+	return nil
+}
+
+// Watch polls the file for changes and reloads it every time its contents change
+func (ft *fileTree) Watch(ctx context.Context) (<-chan error, error) {
+	ft.mu.RLock()
+	contents := ft.contents
+	ft.mu.RUnlock()
+
+	return watchFile(ctx, ft.source, ft.PollInterval, contents, ft.load), nil
+}
+
+func (ft *fileTree) GetValue(fieldPath []string) (string, error) {
+	value, _, err := ft.LookupValue(fieldPath)
+	return value, err
+}
+
+func (ft *fileTree) LookupValue(fieldPath []string) (string, bool, error) {
+	currentValue, err := ft.GetRawValue(fieldPath)
+	if err != nil || currentValue == nil {
+		return "", false, err
+	}
+
+	strValue, ok := currentValue.(string)
+	if !ok {
+		return "", false, errors.New(fmt.Sprintf("got invalid value: %+v", currentValue))
+	}
+
+	return strValue, true, nil
+}
+
+// GetRawValue returns the value as parsed from the file, so lists and objects
+// are returned as []interface{} and map[string]interface{} respectively
+func (ft *fileTree) GetRawValue(fieldPath []string) (interface{}, error) {
+	ft.mu.RLock()
+	defer ft.mu.RUnlock()
+
+	var currentValue interface{} = ft.tree
+
+	for _, path := range fieldPath {
+		m, ok := currentValue.(map[string]interface{})
+		if !ok {
+			return nil, nil
+		}
+
+		currentValue, ok = m[path]
+		if !ok {
+			break
+		}
+	}
+
+	return currentValue, nil
+}
@@ -2,11 +2,20 @@ package providers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/hashicorp/vault-client-go"
 	"golang.org/x/exp/maps"
 )
 
@@ -15,11 +24,105 @@ type VaultAppRoleAuthOptions struct {
 	SecretId string
 }
 
+// VaultKubernetesAuthOptions authenticates with the token of a Kubernetes service account. If JwtFile is set, the
+// token is read from it on every login instead of using Jwt, since projected service account tokens rotate
 type VaultKubernetesAuthOptions struct {
+	Jwt     string
+	JwtFile string
+	Role    string
+}
+
+// VaultTokenAuthOptions authenticates with an existing token. If Token is empty, the token is read from the
+// VAULT_TOKEN environment variable and then from the ~/.vault-token file the Vault CLI writes on login
+type VaultTokenAuthOptions struct {
+	Token string
+}
+
+type VaultUserpassAuthOptions struct {
+	Username string
+	Password string
+}
+
+type VaultJwtAuthOptions struct {
 	Jwt  string
 	Role string
 }
 
+// VaultCertAuthOptions authenticates with a TLS client certificate
+type VaultCertAuthOptions struct {
+	// The path of the PEM-encoded client certificate
+	CertFile string
+
+	// The path of the PEM-encoded private key of the client certificate
+	KeyFile string
+
+	// The name of the certificate role to authenticate against (optional)
+	Name string
+}
+
+// VaultEngine is the kind of secrets engine the secret is read from
+type VaultEngine int
+
+const (
+	// VaultEngineKvV2 reads the latest version of a secret from a KV version 2 engine
+	VaultEngineKvV2 VaultEngine = iota
+
+	// VaultEngineKvV1 reads a secret from a KV version 1 engine
+	VaultEngineKvV1
+
+	// VaultEngineLogical reads any path of the Vault API, e.g. the credentials of a database engine role
+	VaultEngineLogical
+)
+
+// VaultSecretPath is one of the secret paths a VaultProvider fetches
+type VaultSecretPath struct {
+	// The path of the secret
+	Path string
+
+	// The mount path of the secrets engine (default the MountPath of the options)
+	MountPath string
+
+	// The prop prefix the keys of the secret are resolved under, e.g. with the prefix postgres the key PASSWORD
+	// resolves the prop postgres.password. Without a prefix the keys are merged with the ones of the other paths
+	Prefix string
+
+	// The version of a KV v2 secret to read (default 0, the latest version)
+	Version int
+}
+
+// VaultTLSOptions configures the TLS connection to the Vault server
+type VaultTLSOptions struct {
+	// The path of a PEM-encoded CA certificate file (or bundle) to verify the server certificate with
+	CACert string
+
+	// The path of a directory of PEM-encoded CA certificates to verify the server certificate with
+	CAPath string
+
+	// The path of a PEM-encoded client certificate, for servers that require mTLS
+	ClientCert string
+
+	// The path of the PEM-encoded private key of the client certificate
+	ClientKey string
+
+	// The name used to verify the hostname of the server certificate
+	ServerName string
+
+	// Disables the verification of the server certificate. Only use it in development
+	InsecureSkipVerify bool
+}
+
+// VaultRetryOptions configures how failed requests to the Vault server are retried
+type VaultRetryOptions struct {
+	// The maximum number of retries of a request (default 2), a pointer to 0 disables retries
+	MaxRetries *int
+
+	// The minimum time to wait before retrying (default 1s)
+	MinWait time.Duration
+
+	// The maximum time to wait before retrying (default 1.5s)
+	MaxWait time.Duration
+}
+
 type VaultOptions struct {
 	// The Vault Server url
 	Url string
@@ -27,106 +130,592 @@ type VaultOptions struct {
 	// The request timeout for the vault client in seconds (default 1m)
 	RequestTimeout int
 
+	// The Vault Enterprise namespace the requests are sent to (optional)
+	Namespace string
+
+	// Options for the TLS connection to the Vault server (optional)
+	TLS *VaultTLSOptions
+
+	// Options for retrying failed requests (default 2 retries)
+	Retry *VaultRetryOptions
+
+	// The HTTP client the requests are sent with (optional). Its transport must be an *http.Transport, which is copied
+	// to apply the TLS options to
+	HTTPClient *http.Client
+
 	// Options for app role authentication
 	AppRoleAuth *VaultAppRoleAuthOptions
 
 	// Options for kubernetes authentication
 	KubernetesAuth *VaultKubernetesAuthOptions
 
-	// The KV mount path
+	// Options for token authentication
+	TokenAuth *VaultTokenAuthOptions
+
+	// Options for username and password authentication
+	UserpassAuth *VaultUserpassAuthOptions
+
+	// Options for JWT/OIDC authentication
+	JwtAuth *VaultJwtAuthOptions
+
+	// Options for TLS certificate authentication
+	CertAuth *VaultCertAuthOptions
+
+	// The secrets engine the secret is read from (default VaultEngineKvV2)
+	Engine VaultEngine
+
+	// The mount path of the secrets engine. For logical reads it is prepended to the path of the secret
 	MountPath string
 
-	// The path of the secret
+	// The path of the secret (e.g. creds/my-role for the credentials of a database engine role)
 	Path string
+
+	// The version of the KV v2 secret of Path to read (default 0, the latest version)
+	Version int
+
+	// Additional secret paths, which are fetched after Path with the same login
+	Paths []VaultSecretPath
+
+	// The interval at which the secret is fetched again when the provider is watched (default 5m)
+	RefreshInterval time.Duration
 }
 
+const defaultVaultRefreshInterval = 5 * time.Minute
+
 type VaultProvider struct {
-	mountPath string
-	data      map[string]string
+	client  VaultClienter
+	options VaultOptions
+	mu      sync.RWMutex
+	data    map[string]interface{}
+	token   leaseState
+	secrets []vaultSecret
 }
 
 func NewVaultProvider(options VaultOptions) (*VaultProvider, error) {
+	return NewVaultProviderContext(context.Background(), options)
+}
+
+// NewVaultProviderContext creates a new VaultProvider using the given context for authenticating
+// and fetching the secret, so both can be cancelled or bounded by a deadline
+func NewVaultProviderContext(ctx context.Context, options VaultOptions) (*VaultProvider, error) {
 	vaultClient := NewVaultClient()
 	err := validateOptions(options)
 	if err != nil {
 		return nil, fmt.Errorf("vault config invalid: %w", err)
 	}
 
-	ctx := context.Background()
-
-	err = setupVaultClient(ctx, vaultClient, options)
+	token, err := setupVaultClient(ctx, vaultClient, options)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := getSecretData(ctx, vaultClient, options)
+	secrets, err := fetchSecrets(ctx, vaultClient, options)
 	if err != nil {
 		return nil, err
 	}
 
+	return newVaultProvider(vaultClient, options, token, secrets), nil
+}
+
+func newVaultProvider(client VaultClienter, options VaultOptions, token VaultLease, secrets []vaultSecret) *VaultProvider {
 	return &VaultProvider{
-		data: data,
-	}, nil
+		client:  client,
+		options: options,
+		data:    mergeSecrets(secrets),
+		token:   leaseState{lease: token, ttl: token.Duration},
+		secrets: secrets,
+	}
 }
 
 func (vp *VaultProvider) GetValue(fieldPath []string) (string, error) {
+	value, _, err := vp.LookupValue(fieldPath)
+	return value, err
+}
+
+func (vp *VaultProvider) LookupValue(fieldPath []string) (string, bool, error) {
+	value, err := vp.GetRawValue(fieldPath)
+	if err != nil || value == nil {
+		return "", false, err
+	}
+
+	strValue, ok := value.(string)
+	if !ok {
+		return "", false, fmt.Errorf("got invalid value: %+v", value)
+	}
+
+	return strValue, true, nil
+}
+
+// GetRawValue returns the value of a secret key, which is a string or, for lists, a []interface{} of strings
+func (vp *VaultProvider) GetRawValue(fieldPath []string) (interface{}, error) {
+	vp.mu.RLock()
+	defer vp.mu.RUnlock()
+
 	key := strings.ToUpper(strings.Join(fieldPath, "_"))
-	value, exists := vp.data[key]
-	if !exists {
-		return "", nil
-	} else {
-		return value, nil
+	return vp.data[key], nil
+}
+
+// Watch keeps the provider up to date until the context is done. The secrets without a lease are fetched again at
+// the refresh interval of the options, while the auth token and the leased secrets (e.g. database credentials)
+// are renewed after two thirds of their lease. When they cannot be renewed anymore, the provider logs in or
+// fetches the secret again before they expire. A change is signaled every time the data differ from the
+// previous ones, e.g. when the credentials of a dynamic secret rotate
+func (vp *VaultProvider) Watch(ctx context.Context) (<-chan error, error) {
+	interval := vp.options.RefreshInterval
+	if interval <= 0 {
+		interval = defaultVaultRefreshInterval
+	}
+
+	changes := make(chan error)
+
+	go func() {
+		defer close(changes)
+
+		nextRefresh := time.Now().Add(interval)
+
+		for {
+			timer := time.NewTimer(time.Until(vp.nextDeadline(nextRefresh)))
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			now := time.Now()
+
+			refreshDue := !now.Before(nextRefresh)
+			if refreshDue {
+				nextRefresh = now.Add(interval)
+			}
+
+			changed, err := vp.maintain(ctx, now, refreshDue)
+			if changed && !signal(ctx, changes, nil) {
+				return
+			}
+
+			if err != nil && !signal(ctx, changes, err) {
+				return
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+// signal sends the result of a refresh on the changes channel and reports whether it was sent before the context was done
+func signal(ctx context.Context, changes chan<- error, err error) bool {
+	select {
+	case changes <- err:
+		return true
+	case <-ctx.Done():
+		return false
 	}
 }
 
 func validateOptions(options VaultOptions) error {
-	if options.AppRoleAuth == nil && options.KubernetesAuth == nil {
-		return ErrInvalidVaultAuthConfig
+	authMethods := 0
+	for _, set := range []bool{
+		options.AppRoleAuth != nil,
+		options.KubernetesAuth != nil,
+		options.TokenAuth != nil,
+		options.UserpassAuth != nil,
+		options.JwtAuth != nil,
+		options.CertAuth != nil,
+	} {
+		if set {
+			authMethods++
+		}
 	}
 
-	if options.AppRoleAuth != nil && options.KubernetesAuth != nil {
+	if authMethods != 1 {
 		return ErrInvalidVaultAuthConfig
 	}
 
+	if options.Engine < VaultEngineKvV2 || options.Engine > VaultEngineLogical {
+		return ErrInvalidVaultEngine
+	}
+
+	paths := secretPaths(options)
+	if len(paths) == 0 {
+		return ErrInvalidVaultPath
+	}
+
+	for _, secretPath := range paths {
+		if secretPath.Path == "" {
+			return ErrInvalidVaultPath
+		}
+
+		if secretPath.Version < 0 || secretPath.Version > 0 && options.Engine != VaultEngineKvV2 {
+			return ErrInvalidVaultVersion
+		}
+	}
+
 	return nil
 }
 
-func setupVaultClient(ctx context.Context, client VaultClienter, options VaultOptions) error {
-	err := client.Initialize(options.Url, time.Duration(options.RequestTimeout)*time.Second)
+// setupVaultClient initializes the client and logs in, returning the lease of the auth token
+func setupVaultClient(ctx context.Context, client VaultClienter, options VaultOptions) (VaultLease, error) {
+	err := client.Initialize(clientConfiguration(options), options.Namespace)
 	if err != nil {
-		return errors.Join(ErrVaultConnection, err)
+		return VaultLease{}, errors.Join(ErrVaultConnection, err)
 	}
 
-	if options.AppRoleAuth != nil {
-		err = client.AppRoleLogin(ctx, options.AppRoleAuth.RoleId, options.AppRoleAuth.SecretId)
-	} else if options.KubernetesAuth != nil {
-		err = client.KubernetesLogin(ctx, options.KubernetesAuth.Jwt, options.KubernetesAuth.Role)
+	return login(ctx, client, options)
+}
+
+// login authenticates with the auth method of the options and returns the lease of the new token
+func login(ctx context.Context, client VaultClienter, options VaultOptions) (VaultLease, error) {
+	var auth *vault.ResponseAuth
+	var err error
+
+	switch {
+	case options.AppRoleAuth != nil:
+		auth, err = client.AppRoleLogin(ctx, options.AppRoleAuth.RoleId, options.AppRoleAuth.SecretId)
+	case options.KubernetesAuth != nil:
+		var jwt string
+		jwt, err = resolveKubernetesJwt(options.KubernetesAuth)
+		if err == nil {
+			auth, err = client.KubernetesLogin(ctx, jwt, options.KubernetesAuth.Role)
+		}
+	case options.TokenAuth != nil:
+		var token string
+		token, err = resolveVaultToken(options.TokenAuth.Token)
+		if err == nil {
+			auth, err = client.TokenLogin(ctx, token)
+		}
+	case options.UserpassAuth != nil:
+		auth, err = client.UserpassLogin(ctx, options.UserpassAuth.Username, options.UserpassAuth.Password)
+	case options.JwtAuth != nil:
+		auth, err = client.JwtLogin(ctx, options.JwtAuth.Jwt, options.JwtAuth.Role)
+	case options.CertAuth != nil:
+		auth, err = client.CertLogin(ctx, options.CertAuth.Name)
 	}
 
 	if err != nil {
-		return errors.Join(ErrVaultAuth, err)
+		return VaultLease{}, errors.Join(ErrVaultAuth, err)
 	}
 
-	return nil
+	return tokenLease(auth, time.Now()), nil
+}
+
+// clientConfiguration returns the settings the Vault client is created with
+func clientConfiguration(options VaultOptions) vault.ClientConfiguration {
+	return vault.ClientConfiguration{
+		Address:            options.Url,
+		HTTPClient:         options.HTTPClient,
+		RequestTimeout:     time.Duration(options.RequestTimeout) * time.Second,
+		TLS:                tlsConfiguration(options),
+		RetryConfiguration: retryConfiguration(options),
+	}
+}
+
+// tlsConfiguration returns the TLS settings of the Vault client.
+// The certificate of the cert auth options takes precedence over the client certificate of the TLS options
+func tlsConfiguration(options VaultOptions) vault.TLSConfiguration {
+	tls := vault.TLSConfiguration{}
+
+	if options.TLS != nil {
+		tls.ServerCertificate.FromFile = options.TLS.CACert
+		tls.ServerCertificate.FromDirectory = options.TLS.CAPath
+		tls.ClientCertificate.FromFile = options.TLS.ClientCert
+		tls.ClientCertificateKey.FromFile = options.TLS.ClientKey
+		tls.ServerName = options.TLS.ServerName
+		tls.InsecureSkipVerify = options.TLS.InsecureSkipVerify
+	}
+
+	if options.CertAuth != nil && options.CertAuth.CertFile != "" {
+		tls.ClientCertificate.FromFile = options.CertAuth.CertFile
+		tls.ClientCertificateKey.FromFile = options.CertAuth.KeyFile
+	}
+
+	return tls
+}
+
+// retryConfiguration returns the retry settings of the Vault client, using the defaults of the Vault client
+// for the settings that are not set
+func retryConfiguration(options VaultOptions) vault.RetryConfiguration {
+	defaults := vault.DefaultConfiguration().RetryConfiguration
+
+	retry := vault.RetryConfiguration{
+		RetryMax:     defaults.RetryMax,
+		RetryWaitMin: defaults.RetryWaitMin,
+		RetryWaitMax: defaults.RetryWaitMax,
+	}
+
+	if options.Retry != nil {
+		if options.Retry.MaxRetries != nil {
+			retry.RetryMax = *options.Retry.MaxRetries
+		}
+
+		if options.Retry.MinWait > 0 {
+			retry.RetryWaitMin = options.Retry.MinWait
+		}
+
+		if options.Retry.MaxWait > 0 {
+			retry.RetryWaitMax = options.Retry.MaxWait
+		}
+	}
+
+	return retry
+}
+
+// resolveVaultToken returns the given token or, if it is empty, the token of the VAULT_TOKEN environment variable
+// or the ~/.vault-token file, in the same order the Vault CLI looks for it
+func resolveVaultToken(token string) (string, error) {
+	if token != "" {
+		return token, nil
+	}
+
+	if token = os.Getenv("VAULT_TOKEN"); token != "" {
+		return token, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("no token found: %w", err)
+	}
+
+	contents, err := os.ReadFile(filepath.Join(home, ".vault-token"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", errors.New("no token found in VAULT_TOKEN or ~/.vault-token")
+	} else if err != nil {
+		return "", err
+	}
+
+	token = strings.TrimSpace(string(contents))
+	if token == "" {
+		return "", errors.New("the ~/.vault-token file is empty")
+	}
+
+	return token, nil
+}
+
+// resolveKubernetesJwt returns the service account token of the Kubernetes auth options, reading it from JwtFile
+// if it is set
+func resolveKubernetesJwt(options *VaultKubernetesAuthOptions) (string, error) {
+	if options.JwtFile == "" {
+		return options.Jwt, nil
+	}
+
+	contents, err := os.ReadFile(options.JwtFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the Kubernetes service account token: %w", err)
+	}
+
+	return strings.TrimSpace(string(contents)), nil
+}
+
+// fetchSecrets fetches every secret path of the options
+func fetchSecrets(ctx context.Context, client VaultClienter, options VaultOptions) ([]vaultSecret, error) {
+	paths := secretPaths(options)
+	secrets := make([]vaultSecret, len(paths))
+
+	for i, secretPath := range paths {
+		secret, err := fetchSecret(ctx, client, options.Engine, secretPath)
+		if err != nil {
+			return nil, err
+		}
+
+		secrets[i] = secret
+	}
+
+	return secrets, nil
 }
 
-func getSecretData(ctx context.Context, client VaultClienter, options VaultOptions) (map[string]string, error) {
-	result, err := client.GetValues(ctx, options.Path, options.MountPath)
+// fetchSecret fetches a secret path, prefixing its keys with the prefix of the path. The keys are stored the way
+// GetValue looks them up (see secretKey)
+func fetchSecret(ctx context.Context, client VaultClienter, engine VaultEngine, secretPath VaultSecretPath) (vaultSecret, error) {
+	result, secret, err := readSecret(ctx, client, engine, secretPath)
 	if err != nil {
-		return nil, errors.Join(ErrVaultSecretFetch, err)
+		return vaultSecret{}, errors.Join(ErrVaultSecretFetch, fmt.Errorf("path %s: %w", secretPath.Path, err))
 	}
 
-	keys := maps.Keys(result)
-	data := make(map[string]string, len(keys))
+	data := make(map[string]interface{}, len(result))
 
-	for _, key := range keys {
-		value, ok := result[key].(string)
-		if !ok {
-			return nil, ErrVaultSecretValueType
+	for _, key := range sortedKeys(result) {
+		storedKey := key
+		if secretPath.Prefix != "" {
+			storedKey = secretPath.Prefix + "_" + key
 		}
 
-		data[key] = value
+		err := flattenSecretValue(data, secretKey(storedKey), result[key])
+		if err != nil {
+			return vaultSecret{}, fmt.Errorf("%w: path %s: %w", ErrVaultSecretValueType, secretPath.Path, err)
+		}
+	}
+
+	secret.data = data
+	return secret, nil
+}
+
+// flattenSecretValue adds a secret value to the data as a string. Numbers and booleans are converted to their string
+// form, lists of them are kept as lists of strings (so they are split by the items, not a separator) and the keys
+// of nested objects are joined to the key with "_", so they resolve the prop paths of the object.
+// Null values are skipped
+func flattenSecretValue(data map[string]interface{}, key string, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	if object, ok := value.(map[string]interface{}); ok {
+		for _, nestedKey := range sortedKeys(object) {
+			err := flattenSecretValue(data, secretKey(key+"_"+nestedKey), object[nestedKey])
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if list, ok := value.([]interface{}); ok {
+		items := make([]interface{}, len(list))
+
+		for i, item := range list {
+			converted, ok := secretScalar(item)
+			if !ok {
+				return fmt.Errorf("key %s: lists of objects or lists are not supported", key)
+			}
+
+			items[i] = converted
+		}
+
+		data[key] = items
+		return nil
+	}
+
+	converted, ok := secretScalar(value)
+	if !ok {
+		return fmt.Errorf("key %s: unsupported value of type %T", key, value)
+	}
+
+	data[key] = converted
+	return nil
+}
+
+// secretKey normalizes a secret key like the ENV provider keys (all uppercase, with the dots of prefixes replaced
+// by "_"), which is how GetValue looks them up
+func secretKey(key string) string {
+	return strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+// sortedKeys returns the keys of a secret object sorted, so keys that flatten to the same key resolve the same way
+func sortedKeys(object map[string]interface{}) []string {
+	keys := maps.Keys(object)
+	sort.Strings(keys)
+	return keys
+}
+
+// secretScalar converts a string, number or boolean secret value to a string
+func secretScalar(value interface{}) (string, bool) {
+	switch typed := value.(type) {
+	case string:
+		return typed, true
+	case json.Number:
+		return typed.String(), true
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(typed), true
+	case int64:
+		return strconv.FormatInt(typed, 10), true
+	case bool:
+		return strconv.FormatBool(typed), true
+	default:
+		return "", false
+	}
+}
+
+// mergeSecrets merges the data of the secrets.
+// Keys of later paths override the ones of earlier paths, unless they are namespaced under different prefixes
+func mergeSecrets(secrets []vaultSecret) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	for _, secret := range secrets {
+		for key, value := range secret.data {
+			data[key] = value
+		}
+	}
+
+	return data
+}
+
+// secretPaths returns the secret paths of the options, starting with Path if it is set.
+// Paths without a mount path use the MountPath of the options
+func secretPaths(options VaultOptions) []VaultSecretPath {
+	paths := make([]VaultSecretPath, 0, len(options.Paths)+1)
+
+	if options.Path != "" {
+		paths = append(paths, VaultSecretPath{Path: options.Path, Version: options.Version})
+	}
+
+	paths = append(paths, options.Paths...)
+
+	for i := range paths {
+		if paths[i].MountPath == "" {
+			paths[i].MountPath = options.MountPath
+		}
+	}
+
+	return paths
+}
+
+// readSecret reads a secret path with the given engine, returning its raw data and the secret without data.
+// Only logical reads can return a lease (e.g. the credentials of a database engine role) and only KV v2 reads
+// return metadata
+func readSecret(ctx context.Context, client VaultClienter, engine VaultEngine, secretPath VaultSecretPath) (map[string]interface{}, vaultSecret, error) {
+	secret := vaultSecret{
+		leaseState: leaseState{lease: VaultLease{Path: secretPath.Path}},
+		path:       secretPath,
+	}
+
+	switch engine {
+	case VaultEngineKvV1:
+		result, err := client.GetKvV1Values(ctx, secretPath.Path, secretPath.MountPath)
+		return result, secret, err
+	case VaultEngineLogical:
+		res, err := client.GetLogicalValues(ctx, logicalPath(secretPath.MountPath, secretPath.Path))
+		if err != nil {
+			return nil, secret, err
+		}
+
+		if res == nil {
+			return nil, secret, errors.New("secret not found")
+		}
+
+		lease := secretLease(secretPath.Path, res, time.Now())
+		secret.leaseState = leaseState{lease: lease, ttl: lease.Duration}
+
+		return res.Data, secret, nil
+	default:
+		res, err := client.GetValues(ctx, secretPath.Path, secretPath.MountPath, secretPath.Version)
+		if err != nil {
+			return nil, secret, err
+		}
+
+		if res == nil {
+			return nil, secret, errors.New("secret not found")
+		}
+
+		secret.metadata, err = secretMetadata(secretPath.Path, res.Data.Metadata)
+		if err != nil {
+			return nil, secret, err
+		}
+
+		return res.Data.Data, secret, nil
+	}
+}
+
+// logicalPath joins the mount path and the path of a secret into a path of the Vault API
+func logicalPath(mountPath string, path string) string {
+	mountPath = strings.Trim(mountPath, "/")
+	path = strings.Trim(path, "/")
+
+	if mountPath == "" {
+		return path
 	}
 
-	return data, nil
+	return mountPath + "/" + path
 }
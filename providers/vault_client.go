@@ -4,19 +4,33 @@ package providers
 
 import (
 	"context"
-	"time"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/hashicorp/vault-client-go"
 	"github.com/hashicorp/vault-client-go/schema"
 )
 
 // VaultClienter Serves as an abstraction layer to the actual vault client
-// We're using this, so we can unit test the vault provider without worrying about the Vault client
+// We're using this, so we can unit test the vault provider without worrying about the Vault client.
+// The login methods return the auth info of the new token (its TTL and whether it is renewable)
 type VaultClienter interface {
-	Initialize(url string, requestTimeout time.Duration) error
-	AppRoleLogin(ctx context.Context, roleId string, secretId string) error
-	KubernetesLogin(ctx context.Context, jwt string, role string) error
-	GetValues(ctx context.Context, path string, mountPath string) (map[string]interface{}, error)
+	Initialize(config vault.ClientConfiguration, namespace string) error
+	AppRoleLogin(ctx context.Context, roleId string, secretId string) (*vault.ResponseAuth, error)
+	KubernetesLogin(ctx context.Context, jwt string, role string) (*vault.ResponseAuth, error)
+	TokenLogin(ctx context.Context, token string) (*vault.ResponseAuth, error)
+	UserpassLogin(ctx context.Context, username string, password string) (*vault.ResponseAuth, error)
+	JwtLogin(ctx context.Context, jwt string, role string) (*vault.ResponseAuth, error)
+	CertLogin(ctx context.Context, name string) (*vault.ResponseAuth, error)
+	RenewToken(ctx context.Context) (*vault.ResponseAuth, error)
+	RenewLease(ctx context.Context, leaseId string) (*vault.Response[map[string]interface{}], error)
+	GetValues(ctx context.Context, path string, mountPath string, version int) (*vault.Response[schema.KvV2ReadResponse], error)
+	GetKvV1Values(ctx context.Context, path string, mountPath string) (map[string]interface{}, error)
+	GetLogicalValues(ctx context.Context, path string) (*vault.Response[map[string]interface{}], error)
 }
 
 type VaultClient struct {
@@ -27,45 +41,213 @@ func NewVaultClient() *VaultClient {
 	return &VaultClient{}
 }
 
-func (vc *VaultClient) Initialize(url string, requestTimeout time.Duration) error {
-	client, err := vault.New(vault.WithAddress(url), vault.WithRequestTimeout(requestTimeout))
+// Initialize creates the Vault client with the address, HTTP client, request timeout, TLS and retry settings of the
+// given configuration. The settings that are not set (the HTTP client and the retry policies) use the defaults
+// of the Vault client
+func (vc *VaultClient) Initialize(config vault.ClientConfiguration, namespace string) error {
+	defaults := vault.DefaultConfiguration()
+
+	retry := config.RetryConfiguration
+	if retry.CheckRetry == nil {
+		retry.CheckRetry = defaults.RetryConfiguration.CheckRetry
+	}
+
+	if retry.Backoff == nil {
+		retry.Backoff = defaults.RetryConfiguration.Backoff
+	}
+
+	if retry.ErrorHandler == nil {
+		retry.ErrorHandler = defaults.RetryConfiguration.ErrorHandler
+	}
+
+	options := []vault.ClientOption{
+		vault.WithAddress(config.Address),
+		vault.WithRequestTimeout(config.RequestTimeout),
+		vault.WithTLS(config.TLS),
+		vault.WithRetryConfiguration(retry),
+	}
+
+	if config.HTTPClient != nil {
+		options = append(options, vault.WithHTTPClient(cloneHTTPClient(config.HTTPClient)))
+	}
+
+	client, err := vault.New(options...)
 	if err != nil {
 		return err
 	}
 
+	if namespace != "" {
+		err = client.SetNamespace(namespace)
+		if err != nil {
+			return err
+		}
+	}
+
 	vc.client = client
 	return nil
 }
 
-func (vc *VaultClient) AppRoleLogin(ctx context.Context, roleId string, secretId string) error {
+// cloneHTTPClient copies the given HTTP client with a copy of its transport, since the Vault client applies the TLS
+// options to the transport in place (and fails when it has no TLS config). Transports other than *http.Transport are
+// kept, so the Vault client reports them
+func cloneHTTPClient(client *http.Client) *http.Client {
+	cloned := *client
+
+	transport := client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	if httpTransport, ok := transport.(*http.Transport); ok {
+		httpTransport = httpTransport.Clone()
+		if httpTransport.TLSClientConfig == nil {
+			httpTransport.TLSClientConfig = &tls.Config{}
+		}
+
+		cloned.Transport = httpTransport
+	}
+
+	return &cloned
+}
+
+func (vc *VaultClient) AppRoleLogin(ctx context.Context, roleId string, secretId string) (*vault.ResponseAuth, error) {
 	res, err := vc.client.Auth.AppRoleLogin(ctx, schema.AppRoleLoginRequest{
 		RoleId:   roleId,
 		SecretId: secretId,
 	})
-
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return vc.client.SetToken(res.Auth.ClientToken)
+	return vc.setToken(res.Auth)
 }
 
-func (vc *VaultClient) KubernetesLogin(ctx context.Context, jwt string, role string) error {
+func (vc *VaultClient) KubernetesLogin(ctx context.Context, jwt string, role string) (*vault.ResponseAuth, error) {
 	res, err := vc.client.Auth.KubernetesLogin(ctx, schema.KubernetesLoginRequest{
 		Jwt:  jwt,
 		Role: role,
 	})
 	if err != nil {
-		return nil
+		return nil, err
+	}
+
+	return vc.setToken(res.Auth)
+}
+
+// TokenLogin uses the given token and looks it up, since its TTL is not known otherwise
+func (vc *VaultClient) TokenLogin(ctx context.Context, token string) (*vault.ResponseAuth, error) {
+	err := vc.client.SetToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := vc.client.Auth.TokenLookUpSelf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	auth := &vault.ResponseAuth{ClientToken: token}
+
+	if ttl, ok := res.Data["ttl"].(json.Number); ok {
+		seconds, err := ttl.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid token TTL: %w", err)
+		}
+
+		auth.LeaseDuration = int(seconds)
 	}
 
-	return vc.client.SetToken(res.Auth.ClientToken)
+	if renewable, ok := res.Data["renewable"].(bool); ok {
+		auth.Renewable = renewable
+	}
+
+	return auth, nil
 }
 
-func (vc *VaultClient) GetValues(ctx context.Context, path string, mountPath string) (map[string]interface{}, error) {
-	result, err := vc.client.Secrets.KvV2Read(ctx, path, vault.WithMountPath(mountPath))
+func (vc *VaultClient) UserpassLogin(ctx context.Context, username string, password string) (*vault.ResponseAuth, error) {
+	res, err := vc.client.Auth.UserpassLogin(ctx, username, schema.UserpassLoginRequest{
+		Password: password,
+	})
 	if err != nil {
 		return nil, err
 	}
-	return result.Data.Data, nil
+
+	return vc.setToken(res.Auth)
+}
+
+func (vc *VaultClient) JwtLogin(ctx context.Context, jwt string, role string) (*vault.ResponseAuth, error) {
+	res, err := vc.client.Auth.JwtLogin(ctx, schema.JwtLoginRequest{
+		Jwt:  jwt,
+		Role: role,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vc.setToken(res.Auth)
+}
+
+func (vc *VaultClient) CertLogin(ctx context.Context, name string) (*vault.ResponseAuth, error) {
+	res, err := vc.client.Auth.CertLogin(ctx, schema.CertLoginRequest{
+		Name: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vc.setToken(res.Auth)
+}
+
+func (vc *VaultClient) RenewToken(ctx context.Context) (*vault.ResponseAuth, error) {
+	res, err := vc.client.Auth.TokenRenewSelf(ctx, schema.TokenRenewSelfRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Auth == nil {
+		return nil, fmt.Errorf("no auth info in the token renewal response")
+	}
+
+	return res.Auth, nil
+}
+
+func (vc *VaultClient) RenewLease(ctx context.Context, leaseId string) (*vault.Response[map[string]interface{}], error) {
+	return vc.client.System.LeasesRenewLease(ctx, schema.LeasesRenewLeaseRequest{
+		LeaseId: leaseId,
+	})
+}
+
+// GetValues reads a KV v2 secret with its metadata. A version of 0 reads the latest version
+func (vc *VaultClient) GetValues(ctx context.Context, path string, mountPath string, version int) (*vault.Response[schema.KvV2ReadResponse], error) {
+	options := []vault.RequestOption{vault.WithMountPath(mountPath)}
+	if version > 0 {
+		options = append(options, vault.WithCustomQueryParameters(url.Values{"version": {strconv.Itoa(version)}}))
+	}
+
+	return vc.client.Secrets.KvV2Read(ctx, path, options...)
+}
+
+func (vc *VaultClient) GetKvV1Values(ctx context.Context, path string, mountPath string) (map[string]interface{}, error) {
+	result, err := vc.client.Secrets.KvV1Read(ctx, path, vault.WithMountPath(mountPath))
+	if err != nil {
+		return nil, err
+	}
+	return result.Data, nil
+}
+
+func (vc *VaultClient) GetLogicalValues(ctx context.Context, path string) (*vault.Response[map[string]interface{}], error) {
+	return vc.client.Read(ctx, path)
+}
+
+func (vc *VaultClient) setToken(auth *vault.ResponseAuth) (*vault.ResponseAuth, error) {
+	if auth == nil {
+		return nil, fmt.Errorf("no auth info in the login response")
+	}
+
+	err := vc.client.SetToken(auth.ClientToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return auth, nil
 }
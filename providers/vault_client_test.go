@@ -0,0 +1,68 @@
+package providers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// vaultClientTransport returns the transport of the HTTP client the Vault client sends its requests with
+func vaultClientTransport(t *testing.T, client *VaultClient) *http.Transport {
+	transport, ok := client.client.Configuration().HTTPClient.Transport.(*http.Transport)
+	assert.True(t, ok)
+
+	return transport
+}
+
+func TestVaultClient_Initialize(t *testing.T) {
+	t.Run("Applies the TLS options to a copy of a custom HTTP client", func(t *testing.T) {
+		// GIVEN
+		transport := &http.Transport{}
+		httpClient := &http.Client{Transport: transport}
+
+		config := vault.DefaultConfiguration()
+		config.Address = "https://vault.example.com:8200"
+		config.HTTPClient = httpClient
+		config.TLS = vault.TLSConfiguration{ServerName: "vault.internal"}
+
+		client := NewVaultClient()
+
+		// WHEN
+		err := client.Initialize(config, "")
+
+		// THEN
+		assert.Nil(t, err)
+
+		used := vaultClientTransport(t, client)
+		assert.NotSame(t, transport, used)
+		assert.Equal(t, "vault.internal", used.TLSClientConfig.ServerName)
+
+		// The transport of the caller is left as it was
+		assert.Same(t, transport, httpClient.Transport)
+		assert.True(t, transport.TLSClientConfig == nil || transport.TLSClientConfig.ServerName == "")
+	})
+
+	t.Run("Uses a copy of the default transport for a custom HTTP client without one", func(t *testing.T) {
+		// GIVEN
+		config := vault.DefaultConfiguration()
+		config.Address = "https://vault.example.com:8200"
+		config.HTTPClient = &http.Client{}
+		config.TLS = vault.TLSConfiguration{InsecureSkipVerify: true}
+
+		client := NewVaultClient()
+
+		// WHEN
+		err := client.Initialize(config, "team-a")
+
+		// THEN
+		assert.Nil(t, err)
+
+		defaultTransport := http.DefaultTransport.(*http.Transport)
+		used := vaultClientTransport(t, client)
+		assert.NotSame(t, defaultTransport, used)
+		assert.True(t, used.TLSClientConfig.InsecureSkipVerify)
+		assert.True(t, defaultTransport.TLSClientConfig == nil || !defaultTransport.TLSClientConfig.InsecureSkipVerify)
+	})
+}
@@ -0,0 +1,174 @@
+package providers
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// defaultKubernetesTokenPath is where Kubernetes mounts the token of the service account of a pod
+const defaultKubernetesTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// NewVaultProviderFromEnv creates a new VaultProvider for the secret at the given mount path and path,
+// with the rest of the options read from the environment (see VaultOptionsFromEnv)
+func NewVaultProviderFromEnv(mountPath string, path string) (*VaultProvider, error) {
+	options, err := VaultOptionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	options.MountPath = mountPath
+	options.Path = path
+
+	return NewVaultProvider(options)
+}
+
+// VaultOptionsFromEnv builds the connection and auth options from the environment variables of the Vault CLI:
+//
+//	VAULT_ADDR, VAULT_CLIENT_TIMEOUT, VAULT_NAMESPACE
+//	VAULT_CACERT, VAULT_CAPATH, VAULT_CLIENT_CERT, VAULT_CLIENT_KEY, VAULT_TLS_SERVER_NAME, VAULT_SKIP_VERIFY
+//	VAULT_MAX_RETRIES, VAULT_RETRY_WAIT_MIN, VAULT_RETRY_WAIT_MAX
+//
+// The auth method is picked from the first of:
+//
+//	VAULT_ROLE_ID and VAULT_SECRET_ID: AppRole auth
+//	VAULT_KUBERNETES_ROLE: Kubernetes auth with the service account token file of the pod
+//	(or the one at VAULT_KUBERNETES_TOKEN_PATH)
+//	otherwise: token auth with VAULT_TOKEN or ~/.vault-token
+//
+// The secret paths are not set, so they must be set before creating the provider
+func VaultOptionsFromEnv() (VaultOptions, error) {
+	options := VaultOptions{
+		Url:       os.Getenv("VAULT_ADDR"),
+		Namespace: os.Getenv("VAULT_NAMESPACE"),
+	}
+
+	if timeout := os.Getenv("VAULT_CLIENT_TIMEOUT"); timeout != "" {
+		duration, err := parseEnvDuration("VAULT_CLIENT_TIMEOUT", timeout)
+		if err != nil {
+			return VaultOptions{}, err
+		}
+
+		if duration < 0 {
+			return VaultOptions{}, fmt.Errorf("invalid VAULT_CLIENT_TIMEOUT: %s must not be negative", timeout)
+		}
+
+		// Round up to whole seconds, so sub-second timeouts (e.g. 500ms) do not turn into no timeout
+		options.RequestTimeout = int((duration + time.Second - 1) / time.Second)
+	}
+
+	tls, err := tlsOptionsFromEnv()
+	if err != nil {
+		return VaultOptions{}, err
+	}
+
+	options.TLS = tls
+
+	retry, err := retryOptionsFromEnv()
+	if err != nil {
+		return VaultOptions{}, err
+	}
+
+	options.Retry = retry
+
+	roleId, secretId := os.Getenv("VAULT_ROLE_ID"), os.Getenv("VAULT_SECRET_ID")
+	kubernetesRole := os.Getenv("VAULT_KUBERNETES_ROLE")
+
+	switch {
+	case roleId != "" && secretId != "":
+		options.AppRoleAuth = &VaultAppRoleAuthOptions{RoleId: roleId, SecretId: secretId}
+	case kubernetesRole != "":
+		tokenPath := os.Getenv("VAULT_KUBERNETES_TOKEN_PATH")
+		if tokenPath == "" {
+			tokenPath = defaultKubernetesTokenPath
+		}
+
+		// The token file is read on every login, since projected service account tokens rotate
+		options.KubernetesAuth = &VaultKubernetesAuthOptions{JwtFile: tokenPath, Role: kubernetesRole}
+	default:
+		options.TokenAuth = &VaultTokenAuthOptions{Token: os.Getenv("VAULT_TOKEN")}
+	}
+
+	return options, nil
+}
+
+func tlsOptionsFromEnv() (*VaultTLSOptions, error) {
+	tls := VaultTLSOptions{
+		CACert:     os.Getenv("VAULT_CACERT"),
+		CAPath:     os.Getenv("VAULT_CAPATH"),
+		ClientCert: os.Getenv("VAULT_CLIENT_CERT"),
+		ClientKey:  os.Getenv("VAULT_CLIENT_KEY"),
+		ServerName: os.Getenv("VAULT_TLS_SERVER_NAME"),
+	}
+
+	if skipVerify := os.Getenv("VAULT_SKIP_VERIFY"); skipVerify != "" {
+		parsed, err := strconv.ParseBool(skipVerify)
+		if err != nil {
+			return nil, fmt.Errorf("invalid VAULT_SKIP_VERIFY: %w", err)
+		}
+
+		tls.InsecureSkipVerify = parsed
+	}
+
+	if tls == (VaultTLSOptions{}) {
+		return nil, nil
+	}
+
+	return &tls, nil
+}
+
+func retryOptionsFromEnv() (*VaultRetryOptions, error) {
+	maxRetries := os.Getenv("VAULT_MAX_RETRIES")
+	minWait := os.Getenv("VAULT_RETRY_WAIT_MIN")
+	maxWait := os.Getenv("VAULT_RETRY_WAIT_MAX")
+
+	if maxRetries == "" && minWait == "" && maxWait == "" {
+		return nil, nil
+	}
+
+	retry := &VaultRetryOptions{}
+
+	if maxRetries != "" {
+		parsed, err := strconv.Atoi(maxRetries)
+		if err != nil {
+			return nil, fmt.Errorf("invalid VAULT_MAX_RETRIES: %w", err)
+		}
+
+		retry.MaxRetries = &parsed
+	}
+
+	if minWait != "" {
+		parsed, err := parseEnvDuration("VAULT_RETRY_WAIT_MIN", minWait)
+		if err != nil {
+			return nil, err
+		}
+
+		retry.MinWait = parsed
+	}
+
+	if maxWait != "" {
+		parsed, err := parseEnvDuration("VAULT_RETRY_WAIT_MAX", maxWait)
+		if err != nil {
+			return nil, err
+		}
+
+		retry.MaxWait = parsed
+	}
+
+	return retry, nil
+}
+
+// parseEnvDuration parses a duration (e.g. 30s) or a number of seconds, like the Vault CLI does
+func parseEnvDuration(name string, value string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", name, err)
+	}
+
+	return duration, nil
+}
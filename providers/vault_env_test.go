@@ -0,0 +1,167 @@
+package providers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// clearVaultEnv unsets the Vault environment variables of the machine running the tests
+func clearVaultEnv(t *testing.T) {
+	names := []string{
+		"VAULT_ADDR", "VAULT_CLIENT_TIMEOUT", "VAULT_NAMESPACE",
+		"VAULT_CACERT", "VAULT_CAPATH", "VAULT_CLIENT_CERT", "VAULT_CLIENT_KEY", "VAULT_TLS_SERVER_NAME",
+		"VAULT_SKIP_VERIFY", "VAULT_MAX_RETRIES", "VAULT_RETRY_WAIT_MIN", "VAULT_RETRY_WAIT_MAX",
+		"VAULT_TOKEN", "VAULT_ROLE_ID", "VAULT_SECRET_ID", "VAULT_KUBERNETES_ROLE", "VAULT_KUBERNETES_TOKEN_PATH",
+	}
+
+	for _, name := range names {
+		t.Setenv(name, "")
+	}
+}
+
+func TestVaultOptionsFromEnv(t *testing.T) {
+	t.Run("Reads the connection options", func(t *testing.T) {
+		// GIVEN
+		clearVaultEnv(t)
+		t.Setenv("VAULT_ADDR", "https://vault.example.com:8200")
+		t.Setenv("VAULT_CLIENT_TIMEOUT", "30s")
+		t.Setenv("VAULT_NAMESPACE", "team-a")
+		t.Setenv("VAULT_CACERT", "/etc/vault/ca.pem")
+		t.Setenv("VAULT_SKIP_VERIFY", "true")
+		t.Setenv("VAULT_RETRY_WAIT_MAX", "5")
+		t.Setenv("VAULT_TOKEN", "test-token")
+
+		// WHEN
+		options, err := VaultOptionsFromEnv()
+
+		// THEN
+		assert.Nil(t, err)
+		assert.Equal(t, VaultOptions{
+			Url:            "https://vault.example.com:8200",
+			RequestTimeout: 30,
+			Namespace:      "team-a",
+			TLS:            &VaultTLSOptions{CACert: "/etc/vault/ca.pem", InsecureSkipVerify: true},
+			Retry:          &VaultRetryOptions{MaxWait: 5 * time.Second},
+			TokenAuth:      &VaultTokenAuthOptions{Token: "test-token"},
+		}, options)
+	})
+
+	t.Run("Picks the auth method", func(t *testing.T) {
+		tokenPath := "/var/run/secrets/tokens/vault-token"
+
+		testCases := []struct {
+			name     string
+			env      map[string]string
+			expected VaultOptions
+		}{
+			{
+				name:     "token",
+				env:      map[string]string{},
+				expected: VaultOptions{TokenAuth: &VaultTokenAuthOptions{}},
+			},
+			{
+				name: "approle",
+				env:  map[string]string{"VAULT_ROLE_ID": "test-role-id", "VAULT_SECRET_ID": "test-secret-id", "VAULT_TOKEN": "test-token"},
+				expected: VaultOptions{
+					AppRoleAuth: &VaultAppRoleAuthOptions{RoleId: "test-role-id", SecretId: "test-secret-id"},
+				},
+			},
+			{
+				name: "kubernetes with the service account token of the pod",
+				env:  map[string]string{"VAULT_KUBERNETES_ROLE": "test-role"},
+				expected: VaultOptions{
+					KubernetesAuth: &VaultKubernetesAuthOptions{JwtFile: defaultKubernetesTokenPath, Role: "test-role"},
+				},
+			},
+			{
+				name: "kubernetes",
+				env:  map[string]string{"VAULT_KUBERNETES_ROLE": "test-role", "VAULT_KUBERNETES_TOKEN_PATH": tokenPath},
+				expected: VaultOptions{
+					KubernetesAuth: &VaultKubernetesAuthOptions{JwtFile: tokenPath, Role: "test-role"},
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				// GIVEN
+				clearVaultEnv(t)
+				for name, value := range testCase.env {
+					t.Setenv(name, value)
+				}
+
+				// WHEN
+				options, err := VaultOptionsFromEnv()
+
+				// THEN
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.expected, options)
+			})
+		}
+	})
+
+	t.Run("Disables retries", func(t *testing.T) {
+		// GIVEN
+		clearVaultEnv(t)
+		t.Setenv("VAULT_MAX_RETRIES", "0")
+
+		// WHEN
+		options, err := VaultOptionsFromEnv()
+
+		// THEN
+		assert.Nil(t, err)
+		assert.Equal(t, 0, clientConfiguration(options).RetryConfiguration.RetryMax)
+	})
+
+	t.Run("Rounds sub-second timeouts up", func(t *testing.T) {
+		// GIVEN
+		clearVaultEnv(t)
+		t.Setenv("VAULT_CLIENT_TIMEOUT", "1500ms")
+
+		// WHEN
+		options, err := VaultOptionsFromEnv()
+
+		// THEN
+		assert.Nil(t, err)
+		assert.Equal(t, 2, options.RequestTimeout)
+	})
+
+	t.Run("Returns an error for invalid values", func(t *testing.T) {
+		testCases := map[string]string{
+			"VAULT_CLIENT_TIMEOUT": "-1s",
+			"VAULT_SKIP_VERIFY":    "maybe",
+			"VAULT_MAX_RETRIES":    "many",
+			"VAULT_RETRY_WAIT_MIN": "soon",
+		}
+
+		for name, value := range testCases {
+			t.Run(name, func(t *testing.T) {
+				// GIVEN
+				clearVaultEnv(t)
+				t.Setenv(name, value)
+
+				// WHEN
+				_, err := VaultOptionsFromEnv()
+
+				// THEN
+				assert.NotNil(t, err)
+			})
+		}
+	})
+}
+
+func TestNewVaultProviderFromEnv(t *testing.T) {
+	t.Run("Returns an error without a secret path", func(t *testing.T) {
+		// GIVEN
+		clearVaultEnv(t)
+		t.Setenv("VAULT_TOKEN", "test-token")
+
+		// WHEN
+		_, err := NewVaultProviderFromEnv("kv", "")
+
+		// THEN
+		assert.ErrorIs(t, err, ErrInvalidVaultPath)
+	})
+}
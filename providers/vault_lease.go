@@ -0,0 +1,253 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/vault-client-go"
+)
+
+// vaultRetryInterval is the interval at which a failed renewal of the auth token or of a leased secret is retried
+const vaultRetryInterval = 10 * time.Second
+
+// VaultLease describes the lease of the auth token or of a secret of a VaultProvider
+type VaultLease struct {
+	// The id of the lease, empty for the auth token
+	LeaseId string
+
+	// The path of the secret, empty for the auth token
+	Path string
+
+	// The duration of the lease when it was obtained or last renewed, zero if it never expires
+	Duration time.Duration
+
+	// Whether the lease can be renewed
+	Renewable bool
+
+	// When the lease expires, zero if it never expires
+	ExpiresAt time.Time
+}
+
+func newVaultLease(leaseId string, path string, seconds int, renewable bool, now time.Time) VaultLease {
+	lease := VaultLease{
+		LeaseId:   leaseId,
+		Path:      path,
+		Duration:  time.Duration(seconds) * time.Second,
+		Renewable: renewable,
+	}
+
+	if lease.Duration > 0 {
+		lease.ExpiresAt = now.Add(lease.Duration)
+	}
+
+	return lease
+}
+
+func tokenLease(auth *vault.ResponseAuth, now time.Time) VaultLease {
+	if auth == nil {
+		return VaultLease{}
+	}
+
+	return newVaultLease("", "", auth.LeaseDuration, auth.Renewable, now)
+}
+
+// secretLease returns the lease of a secret response. Responses without a lease id have no lease to manage,
+// so their lease duration (a refresh hint for KV secrets) is ignored
+func secretLease(path string, res *vault.Response[map[string]interface{}], now time.Time) VaultLease {
+	if res.LeaseID == "" {
+		return VaultLease{Path: path}
+	}
+
+	return newVaultLease(res.LeaseID, path, res.LeaseDuration, res.Renewable, now)
+}
+
+// renewAt returns when the lease should be renewed (after two thirds of its duration), zero if it never expires
+func (vl VaultLease) renewAt() time.Time {
+	if vl.Duration <= 0 {
+		return time.Time{}
+	}
+
+	return vl.ExpiresAt.Add(-vl.Duration / 3)
+}
+
+// leaseState tracks a lease of a VaultProvider and when it has to be renewed
+type leaseState struct {
+	lease VaultLease
+
+	// The duration of the lease when it was obtained, used to detect when renewals stop extending it (max TTL)
+	ttl time.Duration
+
+	// When to retry after a failed renewal, zero if the last renewal succeeded
+	retryAt time.Time
+}
+
+// deadline returns when the lease has to be renewed, zero if it never expires
+func (ls leaseState) deadline() time.Time {
+	if !ls.retryAt.IsZero() {
+		return ls.retryAt
+	}
+
+	return ls.lease.renewAt()
+}
+
+// extended reports whether a renewed lease is still long enough to keep using it instead of obtaining a new one
+func (ls leaseState) extended(renewed VaultLease) bool {
+	return renewed.Duration > 0 && renewed.Duration >= ls.ttl/3
+}
+
+// vaultSecret is the data of a secret path of a VaultProvider, with their keys already prefixed
+type vaultSecret struct {
+	leaseState
+
+	path     VaultSecretPath
+	data     map[string]interface{}
+	metadata *VaultSecretMetadata
+}
+
+// TokenLease returns the lease of the auth token
+func (vp *VaultProvider) TokenLease() VaultLease {
+	vp.mu.RLock()
+	defer vp.mu.RUnlock()
+
+	return vp.token.lease
+}
+
+// SecretLeases returns the leases of the secrets that have one (e.g. the credentials of a database engine role).
+// KV secrets have no lease, so they are not included
+func (vp *VaultProvider) SecretLeases() []VaultLease {
+	vp.mu.RLock()
+	defer vp.mu.RUnlock()
+
+	leases := make([]VaultLease, 0)
+	for _, secret := range vp.secrets {
+		if secret.lease.LeaseId != "" {
+			leases = append(leases, secret.lease)
+		}
+	}
+
+	return leases
+}
+
+// nextDeadline returns the earliest of the next refresh and the deadlines of the token and the leased secrets
+func (vp *VaultProvider) nextDeadline(nextRefresh time.Time) time.Time {
+	vp.mu.RLock()
+	defer vp.mu.RUnlock()
+
+	next := nextRefresh
+	deadlines := []time.Time{vp.token.deadline()}
+	for _, secret := range vp.secrets {
+		deadlines = append(deadlines, secret.deadline())
+	}
+
+	for _, deadline := range deadlines {
+		if !deadline.IsZero() && deadline.Before(next) {
+			next = deadline
+		}
+	}
+
+	return next
+}
+
+// maintain renews the auth token and the leased secrets whose deadline has passed and fetches again the secrets
+// without a lease if a refresh is due. After logging in again, the leased secrets are fetched again right away,
+// since Vault revokes them with the token they were issued under. It reports whether the data changed
+func (vp *VaultProvider) maintain(ctx context.Context, now time.Time, refreshDue bool) (bool, error) {
+	vp.mu.RLock()
+	token := vp.token
+	secrets := append([]vaultSecret{}, vp.secrets...)
+	vp.mu.RUnlock()
+
+	errs := make([]error, 0)
+
+	loggedIn := false
+	if deadline := token.deadline(); !deadline.IsZero() && !now.Before(deadline) {
+		var err error
+		token, loggedIn, err = vp.renewToken(ctx, token, now)
+		errs = append(errs, err)
+	}
+
+	for i, secret := range secrets {
+		var err error
+		if loggedIn && secret.lease.LeaseId != "" {
+			secrets[i], err = vp.refetchSecret(ctx, secret, now)
+			errs = append(errs, err)
+			continue
+		}
+
+		deadline := secret.deadline()
+		if deadline.IsZero() && !refreshDue || !deadline.IsZero() && now.Before(deadline) {
+			continue
+		}
+
+		secrets[i], err = vp.renewSecret(ctx, secret, now)
+		errs = append(errs, err)
+	}
+
+	data := mergeSecrets(secrets)
+
+	vp.mu.Lock()
+	defer vp.mu.Unlock()
+
+	vp.token = token
+	vp.secrets = secrets
+
+	changed := !reflect.DeepEqual(vp.data, data)
+	vp.data = data
+
+	return changed, errors.Join(errs...)
+}
+
+// renewToken renews the auth token, or logs in again if it is not renewable or has reached its max TTL.
+// It reports whether it logged in again
+func (vp *VaultProvider) renewToken(ctx context.Context, token leaseState, now time.Time) (leaseState, bool, error) {
+	if token.lease.Renewable {
+		auth, err := vp.client.RenewToken(ctx)
+		if err == nil {
+			renewed := tokenLease(auth, now)
+			if token.extended(renewed) {
+				return leaseState{lease: renewed, ttl: token.ttl}, false, nil
+			}
+		}
+	}
+
+	lease, err := login(ctx, vp.client, vp.options)
+	if err != nil {
+		token.retryAt = now.Add(vaultRetryInterval)
+		return token, false, err
+	}
+
+	return leaseState{lease: lease, ttl: lease.Duration}, true, nil
+}
+
+// renewSecret renews the lease of a secret, or fetches it again if it has no lease, is not renewable
+// or has reached its max TTL
+func (vp *VaultProvider) renewSecret(ctx context.Context, secret vaultSecret, now time.Time) (vaultSecret, error) {
+	if secret.lease.LeaseId != "" && secret.lease.Renewable {
+		res, err := vp.client.RenewLease(ctx, secret.lease.LeaseId)
+		if err == nil && res != nil {
+			renewed := newVaultLease(secret.lease.LeaseId, secret.lease.Path, res.LeaseDuration, res.Renewable, now)
+			if secret.extended(renewed) {
+				secret.leaseState = leaseState{lease: renewed, ttl: secret.ttl}
+				return secret, nil
+			}
+		}
+	}
+
+	return vp.refetchSecret(ctx, secret, now)
+}
+
+// refetchSecret fetches a secret again, keeping the current one (retried later if leased) when it fails
+func (vp *VaultProvider) refetchSecret(ctx context.Context, secret vaultSecret, now time.Time) (vaultSecret, error) {
+	fetched, err := fetchSecret(ctx, vp.client, vp.options.Engine, secret.path)
+	if err != nil {
+		if secret.lease.LeaseId != "" {
+			secret.retryAt = now.Add(vaultRetryInterval)
+		}
+
+		return secret, err
+	}
+
+	return fetched, nil
+}
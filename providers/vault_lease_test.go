@@ -0,0 +1,266 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/darklam/gofig/mocks/providers"
+	"github.com/hashicorp/vault-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVaultLease_RenewAt(t *testing.T) {
+	now := time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+
+	lease := newVaultLease("lease-id", "creds/my-role", 60, true, now)
+	assert.Equal(t, now.Add(time.Minute), lease.ExpiresAt)
+	assert.Equal(t, now.Add(40*time.Second), lease.renewAt())
+
+	lease = newVaultLease("", "", 0, false, now)
+	assert.True(t, lease.ExpiresAt.IsZero())
+	assert.True(t, lease.renewAt().IsZero())
+}
+
+func TestVaultProvider_Maintain(t *testing.T) {
+	now := time.Now()
+	expired := now.Add(-time.Second)
+
+	options := VaultOptions{
+		Engine:         VaultEngineLogical,
+		KubernetesAuth: &VaultKubernetesAuthOptions{Jwt: "test-jwt", Role: "test-role"},
+		MountPath:      "database",
+		Path:           "creds/my-role",
+	}
+
+	expiringToken := func(renewable bool) VaultLease {
+		return VaultLease{Duration: time.Hour, Renewable: renewable, ExpiresAt: expired.Add(time.Hour / 3)}
+	}
+
+	leasedSecret := func(renewable bool) vaultSecret {
+		return vaultSecret{
+			leaseState: leaseState{
+				lease: VaultLease{
+					LeaseId:   "old-lease",
+					Path:      options.Path,
+					Duration:  time.Hour,
+					Renewable: renewable,
+					ExpiresAt: expired.Add(time.Hour / 3),
+				},
+				ttl: time.Hour,
+			},
+			path: VaultSecretPath{Path: options.Path, MountPath: options.MountPath},
+			data: map[string]interface{}{"PASSWORD": "old"},
+		}
+	}
+
+	t.Run("Renews a renewable token", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+		provider := newVaultProvider(client, options, expiringToken(true), nil)
+
+		client.
+			EXPECT().
+			RenewToken(mock.Anything).
+			Return(&vault.ResponseAuth{LeaseDuration: 3600, Renewable: true}, nil)
+
+		// WHEN
+		changed, err := provider.maintain(context.Background(), now, false)
+
+		// THEN
+		assert.Nil(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, now.Add(time.Hour), provider.TokenLease().ExpiresAt)
+	})
+
+	t.Run("Logs in again when the token reaches its max TTL", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+		provider := newVaultProvider(client, options, expiringToken(true), nil)
+
+		client.
+			EXPECT().
+			RenewToken(mock.Anything).
+			Return(&vault.ResponseAuth{LeaseDuration: 60, Renewable: true}, nil)
+
+		client.
+			EXPECT().
+			KubernetesLogin(mock.Anything, "test-jwt", "test-role").
+			Return(&vault.ResponseAuth{LeaseDuration: 7200, Renewable: true}, nil)
+
+		// WHEN
+		_, err := provider.maintain(context.Background(), now, false)
+
+		// THEN
+		assert.Nil(t, err)
+		assert.Equal(t, 2*time.Hour, provider.TokenLease().Duration)
+	})
+
+	t.Run("Fetches the leased secrets again after logging in again", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+
+		// The lease of the secret is not due, but it is revoked with the old token
+		secret := leasedSecret(true)
+		secret.lease.ExpiresAt = now.Add(time.Hour)
+		provider := newVaultProvider(client, options, expiringToken(false), []vaultSecret{secret})
+
+		client.
+			EXPECT().
+			KubernetesLogin(mock.Anything, "test-jwt", "test-role").
+			Return(&vault.ResponseAuth{LeaseDuration: 7200, Renewable: true}, nil)
+
+		client.
+			EXPECT().
+			GetLogicalValues(mock.Anything, "database/creds/my-role").
+			Return(&vault.Response[map[string]interface{}]{
+				LeaseID:       "new-lease",
+				LeaseDuration: 3600,
+				Renewable:     true,
+				Data:          map[string]interface{}{"PASSWORD": "new"},
+			}, nil)
+
+		// WHEN
+		changed, err := provider.maintain(context.Background(), now, false)
+
+		// THEN
+		assert.Nil(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, "new-lease", provider.SecretLeases()[0].LeaseId)
+	})
+
+	t.Run("Logs in again with the rotated service account token", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+
+		jwtFile := filepath.Join(t.TempDir(), "token")
+		err := os.WriteFile(jwtFile, []byte("rotated-jwt"), 0o600)
+		assert.Nil(t, err)
+
+		fileOptions := options
+		fileOptions.KubernetesAuth = &VaultKubernetesAuthOptions{JwtFile: jwtFile, Role: "test-role"}
+		provider := newVaultProvider(client, fileOptions, expiringToken(false), nil)
+
+		client.
+			EXPECT().
+			KubernetesLogin(mock.Anything, "rotated-jwt", "test-role").
+			Return(&vault.ResponseAuth{LeaseDuration: 7200, Renewable: true}, nil)
+
+		// WHEN
+		_, err = provider.maintain(context.Background(), now, false)
+
+		// THEN
+		assert.Nil(t, err)
+	})
+
+	t.Run("Retries when the token cannot be renewed", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+		provider := newVaultProvider(client, options, expiringToken(false), nil)
+
+		client.
+			EXPECT().
+			KubernetesLogin(mock.Anything, "test-jwt", "test-role").
+			Return(nil, errors.New("something went wrong"))
+
+		// WHEN
+		_, err := provider.maintain(context.Background(), now, false)
+
+		// THEN
+		assert.ErrorIs(t, err, ErrVaultAuth)
+		assert.Equal(t, now.Add(vaultRetryInterval), provider.nextDeadline(now.Add(time.Hour)))
+	})
+
+	t.Run("Renews a renewable secret lease", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+		provider := newVaultProvider(client, options, VaultLease{}, []vaultSecret{leasedSecret(true)})
+
+		client.
+			EXPECT().
+			RenewLease(mock.Anything, "old-lease").
+			Return(&vault.Response[map[string]interface{}]{LeaseID: "old-lease", LeaseDuration: 3600, Renewable: true}, nil)
+
+		// WHEN
+		changed, err := provider.maintain(context.Background(), now, false)
+
+		// THEN
+		assert.Nil(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, []VaultLease{newVaultLease("old-lease", options.Path, 3600, true, now)}, provider.SecretLeases())
+	})
+
+	t.Run("Fetches the secret again when its lease cannot be renewed", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+		provider := newVaultProvider(client, options, VaultLease{}, []vaultSecret{leasedSecret(true)})
+
+		client.
+			EXPECT().
+			RenewLease(mock.Anything, "old-lease").
+			Return(nil, errors.New("lease expired"))
+
+		client.
+			EXPECT().
+			GetLogicalValues(mock.Anything, "database/creds/my-role").
+			Return(&vault.Response[map[string]interface{}]{
+				LeaseID:       "new-lease",
+				LeaseDuration: 3600,
+				Renewable:     true,
+				Data:          map[string]interface{}{"PASSWORD": "new"},
+			}, nil)
+
+		// WHEN
+		changed, err := provider.maintain(context.Background(), now, false)
+
+		// THEN
+		assert.Nil(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, "new-lease", provider.SecretLeases()[0].LeaseId)
+
+		value, err := provider.GetValue([]string{"password"})
+		assert.Nil(t, err)
+		assert.Equal(t, "new", value)
+	})
+
+	t.Run("Retries when a non renewable secret cannot be fetched", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+		provider := newVaultProvider(client, options, VaultLease{}, []vaultSecret{leasedSecret(false)})
+
+		client.
+			EXPECT().
+			GetLogicalValues(mock.Anything, "database/creds/my-role").
+			Return(nil, errors.New("something went wrong"))
+
+		// WHEN
+		changed, err := provider.maintain(context.Background(), now, false)
+
+		// THEN
+		assert.ErrorIs(t, err, ErrVaultSecretFetch)
+		assert.False(t, changed)
+		assert.Equal(t, now.Add(vaultRetryInterval), provider.nextDeadline(now.Add(time.Hour)))
+
+		value, err := provider.GetValue([]string{"password"})
+		assert.Nil(t, err)
+		assert.Equal(t, "old", value)
+	})
+
+	t.Run("Leases are not renewed before their deadline", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+		token := VaultLease{Duration: time.Hour, ExpiresAt: now.Add(time.Hour)}
+		provider := newVaultProvider(client, options, token, []vaultSecret{leasedSecret(true)})
+
+		// WHEN
+		changed, err := provider.maintain(context.Background(), expired.Add(-time.Minute), true)
+
+		// THEN
+		assert.Nil(t, err)
+		assert.False(t, changed)
+	})
+}
@@ -0,0 +1,77 @@
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// VaultSecretMetadata describes the version of a KV v2 secret read by a VaultProvider
+type VaultSecretMetadata struct {
+	// The path of the secret
+	Path string
+
+	// The version of the secret that was read
+	Version int
+
+	// When the version was created
+	CreatedTime time.Time
+
+	// The custom metadata of the secret
+	CustomMetadata map[string]string
+}
+
+// SecretMetadata returns the metadata of the KV v2 secrets, e.g. to log which versions the process started with.
+// Secrets of other engines have no metadata, so they are not included
+func (vp *VaultProvider) SecretMetadata() []VaultSecretMetadata {
+	vp.mu.RLock()
+	defer vp.mu.RUnlock()
+
+	metadata := make([]VaultSecretMetadata, 0)
+	for _, secret := range vp.secrets {
+		if secret.metadata != nil {
+			metadata = append(metadata, *secret.metadata)
+		}
+	}
+
+	return metadata
+}
+
+// secretMetadata parses the metadata of a KV v2 read response
+func secretMetadata(path string, raw map[string]interface{}) (*VaultSecretMetadata, error) {
+	metadata := &VaultSecretMetadata{
+		Path:           path,
+		CustomMetadata: map[string]string{},
+	}
+
+	switch version := raw["version"].(type) {
+	case json.Number:
+		parsed, err := version.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret version %q: %w", version, err)
+		}
+
+		metadata.Version = int(parsed)
+	case float64:
+		metadata.Version = int(version)
+	case int:
+		metadata.Version = version
+	}
+
+	if createdTime, ok := raw["created_time"].(string); ok && createdTime != "" {
+		parsed, err := time.Parse(time.RFC3339Nano, createdTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret created time %q: %w", createdTime, err)
+		}
+
+		metadata.CreatedTime = parsed
+	}
+
+	if customMetadata, ok := raw["custom_metadata"].(map[string]interface{}); ok {
+		for key, value := range customMetadata {
+			metadata.CustomMetadata[key] = fmt.Sprint(value)
+		}
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,88 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/darklam/gofig/mocks/providers"
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVaultProvider_SecretMetadata(t *testing.T) {
+	// GIVEN
+	client := providers.NewMockVaultClienter(t)
+	ctx := context.Background()
+
+	options := VaultOptions{
+		MountPath: "kv",
+		Path:      "database",
+		Version:   3,
+		Paths:     []VaultSecretPath{{Path: "redis"}},
+	}
+
+	client.
+		EXPECT().
+		GetValues(ctx, "database", "kv", 3).
+		Return(&vault.Response[schema.KvV2ReadResponse]{
+			Data: schema.KvV2ReadResponse{
+				Data: map[string]interface{}{"PASSWORD": "secret"},
+				Metadata: map[string]interface{}{
+					"version":         json.Number("3"),
+					"created_time":    "2023-09-01T10:00:00.123456Z",
+					"custom_metadata": map[string]interface{}{"owner": "platform"},
+				},
+			},
+		}, nil)
+
+	client.
+		EXPECT().
+		GetValues(ctx, "redis", "kv", 0).
+		Return(&vault.Response[schema.KvV2ReadResponse]{
+			Data: schema.KvV2ReadResponse{
+				Data:     map[string]interface{}{"REDIS_PASSWORD": "secret"},
+				Metadata: map[string]interface{}{"version": json.Number("7"), "custom_metadata": nil},
+			},
+		}, nil)
+
+	// WHEN
+	secrets, err := fetchSecrets(ctx, client, options)
+	assert.Nil(t, err)
+
+	provider := newVaultProvider(client, options, VaultLease{}, secrets)
+
+	// THEN
+	assert.Equal(t, []VaultSecretMetadata{
+		{
+			Path:           "database",
+			Version:        3,
+			CreatedTime:    time.Date(2023, 9, 1, 10, 0, 0, 123456000, time.UTC),
+			CustomMetadata: map[string]string{"owner": "platform"},
+		},
+		{
+			Path:           "redis",
+			Version:        7,
+			CustomMetadata: map[string]string{},
+		},
+	}, provider.SecretMetadata())
+}
+
+func TestSecretMetadata_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata map[string]interface{}
+	}{
+		{name: "Invalid version", metadata: map[string]interface{}{"version": json.Number("1.5")}},
+		{name: "Invalid created time", metadata: map[string]interface{}{"created_time": "yesterday"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := secretMetadata("path", test.metadata)
+			assert.NotNil(t, err)
+		})
+	}
+}
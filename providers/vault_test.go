@@ -2,12 +2,20 @@ package providers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/darklam/gofig/mocks/providers"
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"golang.org/x/exp/maps"
 )
 
@@ -20,6 +28,7 @@ func TestValidateOptions(t *testing.T) {
 		{
 			name: "Valid AppRoleAuth Options",
 			options: VaultOptions{
+				Path: "test-path",
 				AppRoleAuth: &VaultAppRoleAuthOptions{
 					RoleId:   "test-role-id",
 					SecretId: "test-secret-id",
@@ -30,6 +39,7 @@ func TestValidateOptions(t *testing.T) {
 		{
 			name: "Valid KubernetesAuth Options",
 			options: VaultOptions{
+				Path: "test-path",
 				KubernetesAuth: &VaultKubernetesAuthOptions{
 					Jwt:  "test-jwt",
 					Role: "test-role",
@@ -40,6 +50,7 @@ func TestValidateOptions(t *testing.T) {
 		{
 			name: "Both Auth Options Present (Invalid)",
 			options: VaultOptions{
+				Path: "test-path",
 				AppRoleAuth: &VaultAppRoleAuthOptions{
 					RoleId:   "test-role-id",
 					SecretId: "test-secret-id",
@@ -59,6 +70,7 @@ func TestValidateOptions(t *testing.T) {
 		{
 			name: "AppRoleAuth Set to nil and KubernetesAuth with Valid Options",
 			options: VaultOptions{
+				Path:        "test-path",
 				AppRoleAuth: nil,
 				KubernetesAuth: &VaultKubernetesAuthOptions{
 					Jwt:  "test-jwt",
@@ -67,9 +79,118 @@ func TestValidateOptions(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "Valid TokenAuth Options",
+			options: VaultOptions{
+				Path:      "test-path",
+				TokenAuth: &VaultTokenAuthOptions{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Valid UserpassAuth Options",
+			options: VaultOptions{
+				Path: "test-path",
+				UserpassAuth: &VaultUserpassAuthOptions{
+					Username: "test-username",
+					Password: "test-password",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Valid JwtAuth Options",
+			options: VaultOptions{
+				Path: "test-path",
+				JwtAuth: &VaultJwtAuthOptions{
+					Jwt:  "test-jwt",
+					Role: "test-role",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Valid CertAuth Options",
+			options: VaultOptions{
+				Path: "test-path",
+				CertAuth: &VaultCertAuthOptions{
+					CertFile: "test-cert-file",
+					KeyFile:  "test-key-file",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "TokenAuth and UserpassAuth Present (Invalid)",
+			options: VaultOptions{
+				Path:      "test-path",
+				TokenAuth: &VaultTokenAuthOptions{Token: "test-token"},
+				UserpassAuth: &VaultUserpassAuthOptions{
+					Username: "test-username",
+					Password: "test-password",
+				},
+			},
+			wantErr: ErrInvalidVaultAuthConfig,
+		},
+		{
+			name: "Unknown Engine (Invalid)",
+			options: VaultOptions{
+				Path: "test-path",
+				AppRoleAuth: &VaultAppRoleAuthOptions{
+					RoleId:   "test-role-id",
+					SecretId: "test-secret-id",
+				},
+				Engine: VaultEngine(42),
+			},
+			wantErr: ErrInvalidVaultEngine,
+		},
+		{
+			name: "Version With KV v2 Engine",
+			options: VaultOptions{
+				TokenAuth: &VaultTokenAuthOptions{},
+				Path:      "test-path",
+				Version:   2,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Version With KV v1 Engine (Invalid)",
+			options: VaultOptions{
+				TokenAuth: &VaultTokenAuthOptions{},
+				Engine:    VaultEngineKvV1,
+				Paths:     []VaultSecretPath{{Path: "test-path", Version: 2}},
+			},
+			wantErr: ErrInvalidVaultVersion,
+		},
+		{
+			name: "Negative Version (Invalid)",
+			options: VaultOptions{
+				TokenAuth: &VaultTokenAuthOptions{},
+				Path:      "test-path",
+				Version:   -1,
+			},
+			wantErr: ErrInvalidVaultVersion,
+		},
+		{
+			name: "No Secret Path (Invalid)",
+			options: VaultOptions{
+				TokenAuth: &VaultTokenAuthOptions{},
+				MountPath: "test-mount-path",
+			},
+			wantErr: ErrInvalidVaultPath,
+		},
+		{
+			name: "Empty Secret Path (Invalid)",
+			options: VaultOptions{
+				TokenAuth: &VaultTokenAuthOptions{},
+				Paths:     []VaultSecretPath{{Path: "test-path"}, {Prefix: "test-prefix"}},
+			},
+			wantErr: ErrInvalidVaultPath,
+		},
 		{
 			name: "KubernetesAuth Set to nil and AppRoleAuth with Valid Options",
 			options: VaultOptions{
+				Path:           "test-path",
 				KubernetesAuth: nil,
 				AppRoleAuth: &VaultAppRoleAuthOptions{
 					RoleId:   "test-role-id",
@@ -90,6 +211,92 @@ func TestValidateOptions(t *testing.T) {
 	}
 }
 
+// expectedConfiguration returns the configuration the Vault client is initialized with when there are no retry options
+func expectedConfiguration(url string, requestTimeout time.Duration, tls vault.TLSConfiguration) vault.ClientConfiguration {
+	return vault.ClientConfiguration{
+		Address:        url,
+		RequestTimeout: requestTimeout,
+		TLS:            tls,
+		RetryConfiguration: vault.RetryConfiguration{
+			RetryMax:     2,
+			RetryWaitMin: time.Second,
+			RetryWaitMax: 1500 * time.Millisecond,
+		},
+	}
+}
+
+func TestClientConfiguration(t *testing.T) {
+	httpClient := &http.Client{}
+
+	options := VaultOptions{
+		Url:            "test-url",
+		RequestTimeout: 15,
+		Namespace:      "test-namespace",
+		TLS: &VaultTLSOptions{
+			CACert:             "test-ca-cert",
+			CAPath:             "test-ca-path",
+			ClientCert:         "test-client-cert",
+			ClientKey:          "test-client-key",
+			ServerName:         "test-server-name",
+			InsecureSkipVerify: true,
+		},
+		Retry: &VaultRetryOptions{
+			MaxRetries: intPointer(5),
+			MaxWait:    3 * time.Second,
+		},
+		HTTPClient: httpClient,
+	}
+
+	assert.Equal(t, vault.ClientConfiguration{
+		Address:        "test-url",
+		HTTPClient:     httpClient,
+		RequestTimeout: 15 * time.Second,
+		TLS: vault.TLSConfiguration{
+			ServerCertificate: vault.ServerCertificateEntry{
+				FromFile:      "test-ca-cert",
+				FromDirectory: "test-ca-path",
+			},
+			ClientCertificate:    vault.ClientCertificateEntry{FromFile: "test-client-cert"},
+			ClientCertificateKey: vault.ClientCertificateKeyEntry{FromFile: "test-client-key"},
+			ServerName:           "test-server-name",
+			InsecureSkipVerify:   true,
+		},
+		RetryConfiguration: vault.RetryConfiguration{
+			RetryMax:     5,
+			RetryWaitMin: time.Second,
+			RetryWaitMax: 3 * time.Second,
+		},
+	}, clientConfiguration(options))
+
+	t.Run("Cert auth certificate takes precedence", func(t *testing.T) {
+		certOptions := options
+		certOptions.CertAuth = &VaultCertAuthOptions{CertFile: "test-cert-file", KeyFile: "test-key-file"}
+
+		tls := clientConfiguration(certOptions).TLS
+		assert.Equal(t, "test-cert-file", tls.ClientCertificate.FromFile)
+		assert.Equal(t, "test-key-file", tls.ClientCertificateKey.FromFile)
+		assert.Equal(t, "test-ca-cert", tls.ServerCertificate.FromFile)
+	})
+
+	t.Run("Retries can be disabled", func(t *testing.T) {
+		noRetryOptions := VaultOptions{Retry: &VaultRetryOptions{MaxRetries: intPointer(0)}}
+
+		assert.Equal(t, 0, clientConfiguration(noRetryOptions).RetryConfiguration.RetryMax)
+	})
+
+	t.Run("Keeps the default retries when only the waits are set", func(t *testing.T) {
+		waitOptions := VaultOptions{Retry: &VaultRetryOptions{MinWait: 2 * time.Second}}
+
+		retry := clientConfiguration(waitOptions).RetryConfiguration
+		assert.Equal(t, 2, retry.RetryMax)
+		assert.Equal(t, 2*time.Second, retry.RetryWaitMin)
+	})
+}
+
+func intPointer(value int) *int {
+	return &value
+}
+
 func TestSetupVaultClient(t *testing.T) {
 	options := VaultOptions{
 		KubernetesAuth: &VaultKubernetesAuthOptions{
@@ -120,16 +327,16 @@ func TestSetupVaultClient(t *testing.T) {
 
 		client.
 			EXPECT().
-			Initialize(options.Url, time.Second*time.Duration(options.RequestTimeout)).
+			Initialize(expectedConfiguration(options.Url, time.Second*time.Duration(options.RequestTimeout), vault.TLSConfiguration{}), "").
 			Return(nil)
 
 		client.
 			EXPECT().
 			KubernetesLogin(ctx, options.KubernetesAuth.Jwt, options.KubernetesAuth.Role).
-			Return(nil)
+			Return(&vault.ResponseAuth{}, nil)
 
 		// WHEN
-		err := setupVaultClient(ctx, client, options)
+		_, err := setupVaultClient(ctx, client, options)
 
 		// THEN
 		assert.Nil(t, err)
@@ -142,16 +349,108 @@ func TestSetupVaultClient(t *testing.T) {
 
 		client.
 			EXPECT().
-			Initialize(options.Url, time.Second*time.Duration(options.RequestTimeout)).
+			Initialize(expectedConfiguration(options.Url, time.Second*time.Duration(options.RequestTimeout), vault.TLSConfiguration{}), "").
 			Return(nil)
 
 		client.
 			EXPECT().
 			AppRoleLogin(ctx, optionsAppRoleAuth.AppRoleAuth.RoleId, optionsAppRoleAuth.AppRoleAuth.SecretId).
+			Return(&vault.ResponseAuth{}, nil)
+
+		// WHEN
+		_, err := setupVaultClient(ctx, client, optionsAppRoleAuth)
+
+		// THEN
+		assert.Nil(t, err)
+	})
+
+	t.Run("Logs in with the other auth methods", func(t *testing.T) {
+		testCases := []struct {
+			name    string
+			options VaultOptions
+			tls     vault.TLSConfiguration
+			expect  func(client *providers.MockVaultClienter, ctx context.Context)
+		}{
+			{
+				name:    "Token",
+				options: VaultOptions{TokenAuth: &VaultTokenAuthOptions{Token: "test-token"}},
+				expect: func(client *providers.MockVaultClienter, ctx context.Context) {
+					client.EXPECT().TokenLogin(ctx, "test-token").Return(&vault.ResponseAuth{}, nil)
+				},
+			},
+			{
+				name: "Userpass",
+				options: VaultOptions{
+					UserpassAuth: &VaultUserpassAuthOptions{Username: "test-username", Password: "test-password"},
+				},
+				expect: func(client *providers.MockVaultClienter, ctx context.Context) {
+					client.EXPECT().UserpassLogin(ctx, "test-username", "test-password").Return(&vault.ResponseAuth{}, nil)
+				},
+			},
+			{
+				name:    "JWT",
+				options: VaultOptions{JwtAuth: &VaultJwtAuthOptions{Jwt: "test-jwt", Role: "test-role"}},
+				expect: func(client *providers.MockVaultClienter, ctx context.Context) {
+					client.EXPECT().JwtLogin(ctx, "test-jwt", "test-role").Return(&vault.ResponseAuth{}, nil)
+				},
+			},
+			{
+				name: "Cert",
+				options: VaultOptions{
+					CertAuth: &VaultCertAuthOptions{CertFile: "test-cert-file", KeyFile: "test-key-file", Name: "test-name"},
+				},
+				tls: vault.TLSConfiguration{
+					ClientCertificate:    vault.ClientCertificateEntry{FromFile: "test-cert-file"},
+					ClientCertificateKey: vault.ClientCertificateKeyEntry{FromFile: "test-key-file"},
+				},
+				expect: func(client *providers.MockVaultClienter, ctx context.Context) {
+					client.EXPECT().CertLogin(ctx, "test-name").Return(&vault.ResponseAuth{}, nil)
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				// GIVEN
+				client := providers.NewMockVaultClienter(t)
+				ctx := context.Background()
+
+				client.
+					EXPECT().
+					Initialize(expectedConfiguration("", 0, testCase.tls), "").
+					Return(nil)
+
+				testCase.expect(client, ctx)
+
+				// WHEN
+				_, err := setupVaultClient(ctx, client, testCase.options)
+
+				// THEN
+				assert.Nil(t, err)
+			})
+		}
+	})
+
+	t.Run("Initialize called with the namespace", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+		ctx := context.Background()
+
+		namespaceOptions := options
+		namespaceOptions.Namespace = "test-namespace"
+
+		client.
+			EXPECT().
+			Initialize(expectedConfiguration(options.Url, time.Second*time.Duration(options.RequestTimeout), vault.TLSConfiguration{}), "test-namespace").
 			Return(nil)
 
+		client.
+			EXPECT().
+			KubernetesLogin(ctx, options.KubernetesAuth.Jwt, options.KubernetesAuth.Role).
+			Return(&vault.ResponseAuth{}, nil)
+
 		// WHEN
-		err := setupVaultClient(ctx, client, optionsAppRoleAuth)
+		_, err := setupVaultClient(ctx, client, namespaceOptions)
 
 		// THEN
 		assert.Nil(t, err)
@@ -164,11 +463,11 @@ func TestSetupVaultClient(t *testing.T) {
 
 		client.
 			EXPECT().
-			Initialize(options.Url, time.Second*time.Duration(options.RequestTimeout)).
+			Initialize(expectedConfiguration(options.Url, time.Second*time.Duration(options.RequestTimeout), vault.TLSConfiguration{}), "").
 			Return(errors.New("something went wrong"))
 
 		// WHEN
-		err := setupVaultClient(ctx, client, options)
+		_, err := setupVaultClient(ctx, client, options)
 
 		// THEN
 		assert.NotNil(t, err)
@@ -182,16 +481,16 @@ func TestSetupVaultClient(t *testing.T) {
 
 		client.
 			EXPECT().
-			Initialize(options.Url, time.Second*time.Duration(options.RequestTimeout)).
+			Initialize(expectedConfiguration(options.Url, time.Second*time.Duration(options.RequestTimeout), vault.TLSConfiguration{}), "").
 			Return(nil)
 
 		client.
 			EXPECT().
 			KubernetesLogin(ctx, options.KubernetesAuth.Jwt, options.KubernetesAuth.Role).
-			Return(errors.New("something went wrong"))
+			Return(nil, errors.New("something went wrong"))
 
 		// WHEN
-		err := setupVaultClient(ctx, client, options)
+		_, err := setupVaultClient(ctx, client, options)
 
 		// THEN
 		assert.NotNil(t, err)
@@ -199,7 +498,84 @@ func TestSetupVaultClient(t *testing.T) {
 	})
 }
 
-func TestGetSecretData(t *testing.T) {
+func kvV2Response(data map[string]interface{}) *vault.Response[schema.KvV2ReadResponse] {
+	return &vault.Response[schema.KvV2ReadResponse]{
+		Data: schema.KvV2ReadResponse{Data: data},
+	}
+}
+
+func TestResolveVaultToken(t *testing.T) {
+	t.Run("Uses the given token", func(t *testing.T) {
+		t.Setenv("VAULT_TOKEN", "env-token")
+
+		token, err := resolveVaultToken("test-token")
+		assert.Nil(t, err)
+		assert.Equal(t, "test-token", token)
+	})
+
+	t.Run("Reads the VAULT_TOKEN environment variable", func(t *testing.T) {
+		t.Setenv("VAULT_TOKEN", "env-token")
+
+		token, err := resolveVaultToken("")
+		assert.Nil(t, err)
+		assert.Equal(t, "env-token", token)
+	})
+
+	t.Run("Reads the token file", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		t.Setenv("VAULT_TOKEN", "")
+
+		err := os.WriteFile(filepath.Join(home, ".vault-token"), []byte("file-token\n"), 0o600)
+		assert.Nil(t, err)
+
+		token, err := resolveVaultToken("")
+		assert.Nil(t, err)
+		assert.Equal(t, "file-token", token)
+	})
+
+	t.Run("Returns an error when there is no token", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv("VAULT_TOKEN", "")
+
+		_, err := resolveVaultToken("")
+		assert.NotNil(t, err)
+	})
+}
+
+func TestResolveKubernetesJwt(t *testing.T) {
+	t.Run("Uses the given token", func(t *testing.T) {
+		jwt, err := resolveKubernetesJwt(&VaultKubernetesAuthOptions{Jwt: "test-jwt", Role: "test-role"})
+		assert.Nil(t, err)
+		assert.Equal(t, "test-jwt", jwt)
+	})
+
+	t.Run("Reads the token file every time", func(t *testing.T) {
+		jwtFile := filepath.Join(t.TempDir(), "token")
+		options := &VaultKubernetesAuthOptions{Jwt: "test-jwt", JwtFile: jwtFile, Role: "test-role"}
+
+		err := os.WriteFile(jwtFile, []byte("old-jwt\n"), 0o600)
+		assert.Nil(t, err)
+
+		jwt, err := resolveKubernetesJwt(options)
+		assert.Nil(t, err)
+		assert.Equal(t, "old-jwt", jwt)
+
+		err = os.WriteFile(jwtFile, []byte("new-jwt\n"), 0o600)
+		assert.Nil(t, err)
+
+		jwt, err = resolveKubernetesJwt(options)
+		assert.Nil(t, err)
+		assert.Equal(t, "new-jwt", jwt)
+	})
+
+	t.Run("Returns an error when the token file is missing", func(t *testing.T) {
+		_, err := resolveKubernetesJwt(&VaultKubernetesAuthOptions{JwtFile: filepath.Join(t.TempDir(), "missing")})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestFetchSecrets(t *testing.T) {
 	options := VaultOptions{
 		Path:      "test-path",
 		MountPath: "test-mount-path",
@@ -212,11 +588,11 @@ func TestGetSecretData(t *testing.T) {
 
 		client.
 			EXPECT().
-			GetValues(ctx, options.Path, options.MountPath).
+			GetValues(ctx, options.Path, options.MountPath, 0).
 			Return(nil, errors.New("something went wrong"))
 
 		// WHEN
-		_, err := getSecretData(ctx, client, options)
+		_, err := fetchSecrets(ctx, client, options)
 
 		// THEN
 		assert.NotNil(t, err)
@@ -236,46 +612,238 @@ func TestGetSecretData(t *testing.T) {
 
 		client.
 			EXPECT().
-			GetValues(ctx, options.Path, options.MountPath).
-			Return(resultMap, nil)
+			GetValues(ctx, options.Path, options.MountPath, 0).
+			Return(kvV2Response(resultMap), nil)
 
 		// WHEN
-		result, err := getSecretData(ctx, client, options)
+		secrets, err := fetchSecrets(ctx, client, options)
+		result := mergeSecrets(secrets)
 
 		// THEN
 		assert.Nil(t, err)
 		for _, key := range maps.Keys(resultMap) {
-			assert.Equal(t, resultMap[key], result[key])
+			assert.Equal(t, resultMap[key], result[strings.ToUpper(key)])
 		}
 	})
 
-	t.Run("Returns correct error when the secret value is not a string", func(t *testing.T) {
+	t.Run("Reads from a KV v1 engine", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+		ctx := context.Background()
+
+		kvV1Options := options
+		kvV1Options.Engine = VaultEngineKvV1
+
+		client.
+			EXPECT().
+			GetKvV1Values(ctx, options.Path, options.MountPath).
+			Return(map[string]interface{}{"test": "value"}, nil)
+
+		// WHEN
+		secrets, err := fetchSecrets(ctx, client, kvV1Options)
+		result := mergeSecrets(secrets)
+
+		// THEN
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"TEST": "value"}, result)
+	})
+
+	t.Run("Reads a logical path", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+		ctx := context.Background()
+
+		logicalOptions := VaultOptions{
+			Engine:    VaultEngineLogical,
+			MountPath: "database/",
+			Path:      "/creds/my-role",
+		}
+
+		client.
+			EXPECT().
+			GetLogicalValues(ctx, "database/creds/my-role").
+			Return(&vault.Response[map[string]interface{}]{
+				Data: map[string]interface{}{"username": "user", "password": "secret"},
+			}, nil)
+
+		// WHEN
+		secrets, err := fetchSecrets(ctx, client, logicalOptions)
+		result := mergeSecrets(secrets)
+
+		// THEN
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"USERNAME": "user", "PASSWORD": "secret"}, result)
+	})
+
+	t.Run("Reads multiple paths", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+		ctx := context.Background()
+
+		multiPathOptions := VaultOptions{
+			MountPath: "kv",
+			Path:      "common",
+			Paths: []VaultSecretPath{
+				{Path: "database", Prefix: "postgres"},
+				{Path: "redis", MountPath: "other", Prefix: "cache.redis"},
+				{Path: "api-keys"},
+			},
+		}
+
+		client.
+			EXPECT().
+			GetValues(ctx, "common", "kv", 0).
+			Return(kvV2Response(map[string]interface{}{"PASSWORD": "common", "API_KEY": "old"}), nil)
+
+		client.
+			EXPECT().
+			GetValues(ctx, "database", "kv", 0).
+			Return(kvV2Response(map[string]interface{}{"PASSWORD": "postgres"}), nil)
+
+		client.
+			EXPECT().
+			GetValues(ctx, "redis", "other", 0).
+			Return(kvV2Response(map[string]interface{}{"PASSWORD": "redis"}), nil)
+
+		client.
+			EXPECT().
+			GetValues(ctx, "api-keys", "kv", 0).
+			Return(kvV2Response(map[string]interface{}{"API_KEY": "new"}), nil)
+
+		// WHEN
+		secrets, err := fetchSecrets(ctx, client, multiPathOptions)
+		result := mergeSecrets(secrets)
+
+		// THEN
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"PASSWORD":             "common",
+			"API_KEY":              "new",
+			"POSTGRES_PASSWORD":    "postgres",
+			"CACHE_REDIS_PASSWORD": "redis",
+		}, result)
+	})
+
+	t.Run("Converts numbers, booleans, lists and nested objects", func(t *testing.T) {
 		// GIVEN
 		client := providers.NewMockVaultClienter(t)
 		ctx := context.Background()
 
 		resultMap := map[string]interface{}{
-			"test":    "value",
-			"invalid": 100,
+			"PORT":    json.Number("5432"),
+			"RATIO":   0.5,
+			"ENABLED": true,
+			"HOSTS":   []interface{}{"a.example.com", "b,c.example.com", json.Number("8080")},
+			"MISSING": nil,
+			"postgres": map[string]interface{}{
+				"host": "localhost",
+				"pool": map[string]interface{}{"max": json.Number("10")},
+			},
 		}
 
 		client.
 			EXPECT().
-			GetValues(ctx, options.Path, options.MountPath).
-			Return(resultMap, nil)
+			GetValues(ctx, options.Path, options.MountPath, 0).
+			Return(kvV2Response(resultMap), nil)
 
 		// WHEN
-		_, err := getSecretData(ctx, client, options)
+		secrets, err := fetchSecrets(ctx, client, options)
+		result := mergeSecrets(secrets)
+
+		// THEN
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"PORT":              "5432",
+			"RATIO":             "0.5",
+			"ENABLED":           "true",
+			"HOSTS":             []interface{}{"a.example.com", "b,c.example.com", "8080"},
+			"POSTGRES_HOST":     "localhost",
+			"POSTGRES_POOL_MAX": "10",
+		}, result)
+	})
+
+	t.Run("Resolves mixed case keys", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+		ctx := context.Background()
+
+		mixedCaseOptions := VaultOptions{
+			MountPath: "kv",
+			Paths: []VaultSecretPath{
+				{Path: "database"},
+				{Path: "cache", Prefix: "cache.Redis"},
+			},
+		}
+
+		client.
+			EXPECT().
+			GetValues(ctx, "database", "kv", 0).
+			Return(kvV2Response(map[string]interface{}{
+				"postgres": map[string]interface{}{"host": "localhost"},
+				"port":     "5432",
+				"UserName": "user",
+			}), nil)
+
+		client.
+			EXPECT().
+			GetValues(ctx, "cache", "kv", 0).
+			Return(kvV2Response(map[string]interface{}{"password": "redis"}), nil)
+
+		secrets, err := fetchSecrets(ctx, client, mixedCaseOptions)
+		assert.Nil(t, err)
+
+		provider := newVaultProvider(client, mixedCaseOptions, VaultLease{}, secrets)
+
+		testCases := []struct {
+			fieldPath []string
+			expected  string
+		}{
+			{fieldPath: []string{"postgres", "host"}, expected: "localhost"},
+			{fieldPath: []string{"port"}, expected: "5432"},
+			{fieldPath: []string{"userName"}, expected: "user"},
+			{fieldPath: []string{"cache", "redis", "password"}, expected: "redis"},
+		}
+
+		for _, testCase := range testCases {
+			// WHEN
+			value, found, err := provider.LookupValue(testCase.fieldPath)
+
+			// THEN
+			assert.Nil(t, err)
+			assert.True(t, found, "%v not found", testCase.fieldPath)
+			assert.Equal(t, testCase.expected, value)
+		}
+	})
+
+	t.Run("Returns correct error when the secret value cannot be converted", func(t *testing.T) {
+		// GIVEN
+		client := providers.NewMockVaultClienter(t)
+		ctx := context.Background()
+
+		resultMap := map[string]interface{}{
+			"test": "value",
+			"nested": map[string]interface{}{
+				"invalid": []interface{}{map[string]interface{}{"name": "value"}},
+			},
+		}
+
+		client.
+			EXPECT().
+			GetValues(ctx, options.Path, options.MountPath, 0).
+			Return(kvV2Response(resultMap), nil)
+
+		// WHEN
+		_, err := fetchSecrets(ctx, client, options)
 
 		// THEN
-		assert.NotNil(t, err)
 		assert.ErrorIs(t, err, ErrVaultSecretValueType)
+		assert.ErrorContains(t, err, "NESTED_INVALID")
 	})
 }
 
 func TestVaultProvider_GetValue(t *testing.T) {
 	provider := &VaultProvider{
-		data: map[string]string{
+		data: map[string]interface{}{
 			"SOME_KEY":                   "some_value",
 			"ANOTHER_KEY_MULTIPLE_PARTS": "foo",
 			"MIXED_CASING":               "something",
@@ -317,3 +885,93 @@ func TestVaultProvider_GetValue(t *testing.T) {
 		})
 	}
 }
+
+func TestVaultProvider_LookupValue(t *testing.T) {
+	provider := &VaultProvider{
+		data: map[string]interface{}{
+			"SOME_KEY":  "some_value",
+			"EMPTY_KEY": "",
+		},
+	}
+
+	value, found, err := provider.LookupValue([]string{"some", "key"})
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "some_value", value)
+
+	value, found, err = provider.LookupValue([]string{"empty", "key"})
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "", value)
+
+	_, found, err = provider.LookupValue([]string{"not", "found"})
+	assert.Nil(t, err)
+	assert.False(t, found)
+}
+
+func TestVaultProvider_GetRawValue(t *testing.T) {
+	provider := &VaultProvider{
+		data: map[string]interface{}{
+			"SOME_KEY": "some_value",
+			"HOSTS":    []interface{}{"a,b", "c"},
+		},
+	}
+
+	value, err := provider.GetRawValue([]string{"some", "key"})
+	assert.Nil(t, err)
+	assert.Equal(t, "some_value", value)
+
+	value, err = provider.GetRawValue([]string{"hosts"})
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"a,b", "c"}, value)
+
+	value, err = provider.GetRawValue([]string{"not", "found"})
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+
+	_, _, err = provider.LookupValue([]string{"hosts"})
+	assert.NotNil(t, err)
+}
+
+func TestVaultProvider_Watch(t *testing.T) {
+	// GIVEN
+	client := providers.NewMockVaultClienter(t)
+
+	options := VaultOptions{
+		Path:            "test-path",
+		MountPath:       "test-mount-path",
+		RefreshInterval: 10 * time.Millisecond,
+	}
+
+	provider := newVaultProvider(client, options, VaultLease{}, []vaultSecret{
+		{
+			path: VaultSecretPath{Path: options.Path, MountPath: options.MountPath},
+			data: map[string]interface{}{"PASSWORD": "old"},
+		},
+	})
+
+	client.
+		EXPECT().
+		GetValues(mock.Anything, options.Path, options.MountPath, 0).
+		Return(kvV2Response(map[string]interface{}{"PASSWORD": "new"}), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// WHEN
+	changes, err := provider.Watch(ctx)
+
+	// THEN
+	assert.Nil(t, err)
+
+	select {
+	case err := <-changes:
+		assert.Nil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("no change received")
+	}
+
+	value, err := provider.GetValue([]string{"password"})
+	assert.Nil(t, err)
+	assert.Equal(t, "new", value)
+}
@@ -0,0 +1,70 @@
+package providers
+
+import (
+	"fmt"
+	"io/fs"
+
+	"gopkg.in/yaml.v3"
+)
+
+type YAMLProvider struct {
+	fileTree
+}
+
+func NewYAMLProvider(filePath string) (*YAMLProvider, error) {
+	return newYAMLProvider(fileSource{path: filePath})
+}
+
+func NewYAMLProviderFromFs(fs fs.FS, filePath string) (*YAMLProvider, error) {
+	return newYAMLProvider(fileSource{fsys: fs, path: filePath})
+}
+
+func newYAMLProvider(source fileSource) (*YAMLProvider, error) {
+	yp := &YAMLProvider{}
+
+	err := yp.init(source, parseYAML)
+	if err != nil {
+		return nil, err
+	}
+
+	return yp, nil
+}
+
+func parseYAML(contents []byte) (map[string]interface{}, error) {
+	parsed := map[string]interface{}{}
+
+	err := yaml.Unmarshal(contents, &parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	return normalizeYAML(parsed).(map[string]interface{}), nil
+}
+
+// normalizeYAML converts the mappings with non-string keys (e.g. numbers) that the YAML decoder returns
+// as map[interface{}]interface{} into map[string]interface{}, so they can be walked by prop paths
+func normalizeYAML(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, item := range typed {
+			typed[key] = normalizeYAML(item)
+		}
+
+		return typed
+	case map[interface{}]interface{}:
+		normalized := make(map[string]interface{}, len(typed))
+		for key, item := range typed {
+			normalized[fmt.Sprint(key)] = normalizeYAML(item)
+		}
+
+		return normalized
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = normalizeYAML(item)
+		}
+
+		return typed
+	default:
+		return value
+	}
+}
@@ -0,0 +1,118 @@
+package providers
+
+import (
+	"embed"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//go:embed test.config.yaml
+var yamlConfig embed.FS
+
+func TestYAMLProvider_GetRawValue(t *testing.T) {
+	yp, err := NewYAMLProviderFromFs(yamlConfig, "test.config.yaml")
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name      string
+		fieldPath []string
+		expected  interface{}
+	}{
+		{
+			name:      "String value",
+			fieldPath: []string{"some"},
+			expected:  "value",
+		},
+		{
+			name:      "Nested key",
+			fieldPath: []string{"nested", "key"},
+			expected:  "value",
+		},
+		{
+			name:      "Deeply nested int",
+			fieldPath: []string{"nested", "deeper", "port"},
+			expected:  5432,
+		},
+		{
+			name:      "List",
+			fieldPath: []string{"list"},
+			expected:  []interface{}{"a", "b"},
+		},
+		{
+			name:      "Non-string mapping key",
+			fieldPath: []string{"numbers", "1"},
+			expected:  "one",
+		},
+		{
+			name:      "Bool",
+			fieldPath: []string{"enabled"},
+			expected:  true,
+		},
+		{
+			name:      "Float",
+			fieldPath: []string{"ratio"},
+			expected:  0.5,
+		},
+		{
+			name:      "Timestamp",
+			fieldPath: []string{"created"},
+			expected:  time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "Invalid key",
+			fieldPath: []string{"nonexistent"},
+			expected:  nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := yp.GetRawValue(test.fieldPath)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestYAMLProvider_LookupValue(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "tests")
+	assert.Nil(t, err)
+
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.WriteString("postgres:\n  host: db\n  password: \"\"\n")
+	assert.Nil(t, err)
+
+	yp, err := NewYAMLProvider(tmpFile.Name())
+	assert.Nil(t, err)
+
+	value, found, err := yp.LookupValue([]string{"postgres", "host"})
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "db", value)
+
+	value, found, err = yp.LookupValue([]string{"postgres", "password"})
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "", value)
+
+	_, found, err = yp.LookupValue([]string{"postgres", "port"})
+	assert.Nil(t, err)
+	assert.False(t, found)
+}
+
+func TestNewYAMLProvider_InvalidFile(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "tests")
+	assert.Nil(t, err)
+
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.WriteString("key: [unclosed")
+	assert.Nil(t, err)
+
+	_, err = NewYAMLProvider(tmpFile.Name())
+	assert.NotNil(t, err)
+}
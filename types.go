@@ -1,9 +1,30 @@
 package gofig
 
-import "reflect"
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/darklam/gofig/interfaces"
+)
 
 type Field struct {
 	field       reflect.StructField
 	parentValue reflect.Value
 	fullPath    []string
+	goPath      []string
+}
+
+type registeredProvider struct {
+	name     string
+	provider interfaces.Provider
+}
+
+// displayName returns the name used to refer to the provider in errors and provenance,
+// which is the name it was registered with or its type if it has no name
+func (rp registeredProvider) displayName() string {
+	if rp.name != "" {
+		return rp.name
+	}
+
+	return fmt.Sprintf("%T", rp.provider)
 }
@@ -12,30 +12,44 @@ func pop[T any](slice *[]T) T {
 	return last
 }
 
+// getFields returns the fields of the given struct type which Gofig can populate along with their full path.
+// Unexported fields are skipped, except for embedded structs since their promoted fields can still be set.
+// Embedded structs without a prop tag share the path of their parent, so their fields are flattened into it
 func getFields(t reflect.Type, parent *Field, parentValue reflect.Value) []Field {
-	visible := reflect.VisibleFields(t)
+	fields := make([]Field, 0, t.NumField())
 
-	fields := make([]Field, len(visible))
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if !field.IsExported() && !(field.Anonymous && field.Type.Kind() == reflect.Struct) {
+			continue
+		}
 
-	for i, field := range visible {
 		currentPath := make([]string, 0)
+		goPath := make([]string, 0)
 
 		if parent != nil {
 			parentPath := parent.fullPath
 			for _, f := range parentPath {
 				currentPath = append(currentPath, f)
 			}
+
+			goPath = append(goPath, parent.goPath...)
 		}
 
+		goPath = append(goPath, field.Name)
+
 		fieldPath := field.Tag.Get("prop")
 
-		parts := strings.Split(fieldPath, ".")
+		if !field.Anonymous || fieldPath != "" {
+			parts := strings.Split(fieldPath, ".")
 
-		for _, part := range parts {
-			currentPath = append(currentPath, part)
+			for _, part := range parts {
+				currentPath = append(currentPath, part)
+			}
 		}
 
-		fields[i] = Field{field: field, parentValue: parentValue, fullPath: currentPath}
+		fields = append(fields, Field{field: field, parentValue: parentValue, fullPath: currentPath, goPath: goPath})
 	}
 
 	return fields
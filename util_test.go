@@ -61,4 +61,42 @@ func TestUtil_GetVisibleFieldPairs(t *testing.T) {
 	assert.Equal(t, field2.fullPath[0], "super")
 	assert.Equal(t, field2.fullPath[1], "secret")
 	assert.Equal(t, field2.fullPath[2], "password")
+	assert.Equal(t, field2.goPath, []string{"SuperSecretPassword"})
+}
+
+func TestUtil_GetFieldsEmbedded(t *testing.T) {
+	// GIVEN
+	type common struct {
+		LogLevel string `prop:"log.level"`
+	}
+
+	type Shared struct {
+		Host string `prop:"host"`
+	}
+
+	type s struct {
+		common
+		Shared    `prop:"shared"`
+		Field1    string `prop:"field1"`
+		unexposed string
+	}
+
+	st := new(s)
+
+	parentValue := reflect.ValueOf(st).Elem()
+
+	// WHEN
+	pairs := getFields(reflect.TypeOf(st).Elem(), nil, parentValue)
+
+	// THEN
+	assert.Equal(t, 3, len(pairs))
+	fields := map[string]Field{}
+	for _, pair := range pairs {
+		fields[pair.field.Name] = pair
+	}
+
+	assert.Empty(t, fields["common"].fullPath)
+	assert.Equal(t, []string{"shared"}, fields["Shared"].fullPath)
+	assert.Equal(t, []string{"field1"}, fields["Field1"].fullPath)
+	assert.NotContains(t, fields, "unexposed")
 }
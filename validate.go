@@ -0,0 +1,226 @@
+package gofig
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type validationRule struct {
+	name string
+	arg  string
+}
+
+// parseRules parses the rules of a validate tag, e.g. "min=1,max=10".
+// Since regular expressions can contain commas, a regexp rule consumes the rest of the tag, so it must be the last one
+func parseRules(tag string) []validationRule {
+	rules := make([]validationRule, 0)
+
+	for tag != "" {
+		// Trim the spaces after the previous comma first, so a regexp rule is recognized after them
+		tag = strings.TrimLeftFunc(tag, unicode.IsSpace)
+
+		var part string
+		if strings.HasPrefix(tag, "regexp=") {
+			part, tag = tag, ""
+		} else {
+			part, tag, _ = strings.Cut(tag, ",")
+		}
+
+		name, arg, _ := strings.Cut(strings.TrimSpace(part), "=")
+		if name == "" {
+			continue
+		}
+
+		rules = append(rules, validationRule{name: name, arg: arg})
+	}
+
+	return rules
+}
+
+// validateField checks the value of a field against all the rules of its validate tag. If no value was resolved for
+// the field, its zero value is only checked against the rules that apply to unset fields (see appliesWhenUnset)
+func validateField(fieldValue reflect.Value, field reflect.StructField, resolved bool) error {
+	errs := make([]error, 0)
+
+	for _, rule := range parseRules(field.Tag.Get("validate")) {
+		if !resolved && !rule.appliesWhenUnset() {
+			continue
+		}
+
+		err := rule.check(fieldValue)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// appliesWhenUnset reports whether the rule is checked for fields without a value. The rules that only check the
+// format of a value (oneof, regexp, url) or cap it (max) are not, so fields with them stay optional, while nonempty
+// and min require a value. Unknown rules are still checked, so they are reported
+func (rule validationRule) appliesWhenUnset() bool {
+	switch rule.name {
+	case "max", "oneof", "regexp", "url":
+		return false
+	default:
+		return true
+	}
+}
+
+func (rule validationRule) check(fieldValue reflect.Value) error {
+	switch rule.name {
+	case "min":
+		cmp, err := compareTo(fieldValue, rule.arg)
+		if err != nil {
+			return err
+		}
+
+		if cmp < 0 {
+			return fmt.Errorf("%w: must be at least %s", ErrValidation, rule.arg)
+		}
+	case "max":
+		cmp, err := compareTo(fieldValue, rule.arg)
+		if err != nil {
+			return err
+		}
+
+		if cmp > 0 {
+			return fmt.Errorf("%w: must be at most %s", ErrValidation, rule.arg)
+		}
+	case "oneof":
+		options := strings.Fields(rule.arg)
+
+		return eachScalar(fieldValue, func(value string) error {
+			for _, option := range options {
+				if value == option {
+					return nil
+				}
+			}
+
+			return fmt.Errorf("%w: %q must be one of [%s]", ErrValidation, value, strings.Join(options, " "))
+		})
+	case "regexp":
+		expression, err := regexp.Compile(rule.arg)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidValidationRule, err)
+		}
+
+		return eachScalar(fieldValue, func(value string) error {
+			if !expression.MatchString(value) {
+				return fmt.Errorf("%w: %q must match %s", ErrValidation, value, rule.arg)
+			}
+
+			return nil
+		})
+	case "url":
+		return eachScalar(fieldValue, func(value string) error {
+			parsed, err := url.ParseRequestURI(value)
+			if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+				return fmt.Errorf("%w: %q must be a valid URL", ErrValidation, value)
+			}
+
+			return nil
+		})
+	case "nonempty":
+		if fieldValue.IsZero() || isEmptyCollection(fieldValue) {
+			return fmt.Errorf("%w: must not be empty", ErrValidation)
+		}
+	default:
+		return fmt.Errorf("%w: unknown rule %q", ErrInvalidValidationRule, rule.name)
+	}
+
+	return nil
+}
+
+// compareTo compares the value of a field to the argument of a min/max rule, returning -1, 0 or 1.
+// Numbers and durations are compared by value, while strings, slices and maps are compared by length
+func compareTo(fieldValue reflect.Value, arg string) (int, error) {
+	invalidArg := func(err error) (int, error) {
+		return 0, fmt.Errorf("%w: invalid limit %q: %w", ErrInvalidValidationRule, arg, err)
+	}
+
+	if fieldValue.Type() == durationType {
+		limit, err := time.ParseDuration(arg)
+		if err != nil {
+			return invalidArg(err)
+		}
+
+		return compare(fieldValue.Int(), int64(limit)), nil
+	}
+
+	switch fieldValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		limit, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return invalidArg(err)
+		}
+
+		return compare(fieldValue.Int(), limit), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		limit, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return invalidArg(err)
+		}
+
+		return compare(fieldValue.Uint(), limit), nil
+	case reflect.Float32, reflect.Float64:
+		limit, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return invalidArg(err)
+		}
+
+		return compare(fieldValue.Float(), limit), nil
+	case reflect.String, reflect.Slice, reflect.Map:
+		limit, err := strconv.Atoi(arg)
+		if err != nil {
+			return invalidArg(err)
+		}
+
+		return compare(fieldValue.Len(), limit), nil
+	default:
+		return 0, fmt.Errorf("%w: min and max are not supported for %s", ErrInvalidValidationRule, fieldValue.Type())
+	}
+}
+
+func compare[T int | int64 | uint64 | float64](a T, b T) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+
+	return 0
+}
+
+// eachScalar calls check with the string form of the value of the field, or of each item if the field is a slice
+func eachScalar(fieldValue reflect.Value, check func(value string) error) error {
+	if fieldValue.Kind() != reflect.Slice {
+		return check(fmt.Sprint(fieldValue.Interface()))
+	}
+
+	for i := 0; i < fieldValue.Len(); i++ {
+		err := check(fmt.Sprint(fieldValue.Index(i).Interface()))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isEmptyCollection(fieldValue reflect.Value) bool {
+	switch fieldValue.Kind() {
+	case reflect.Slice, reflect.Map:
+		return fieldValue.Len() == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,79 @@
+package gofig
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate_ParseRules(t *testing.T) {
+	rules := parseRules("min=1, max=10,nonempty,regexp=^[a-z]{1,3}$")
+
+	assert.Equal(t, []validationRule{
+		{name: "min", arg: "1"},
+		{name: "max", arg: "10"},
+		{name: "nonempty"},
+		{name: "regexp", arg: "^[a-z]{1,3}$"},
+	}, rules)
+}
+
+func TestValidate_ParseRulesRegexpAfterSpace(t *testing.T) {
+	rules := parseRules("min=1, regexp=^a,b$")
+
+	assert.Equal(t, []validationRule{
+		{name: "min", arg: "1"},
+		{name: "regexp", arg: "^a,b$"},
+	}, rules)
+}
+
+func TestValidate_ValidateField(t *testing.T) {
+	testCases := []struct {
+		name    string
+		value   interface{}
+		tag     string
+		unset   bool
+		wantErr error
+	}{
+		{name: "Int within limits", value: 8080, tag: "min=1,max=65535"},
+		{name: "Int below min", value: 0, tag: "min=1,max=65535", wantErr: ErrValidation},
+		{name: "Uint above max", value: uint(70000), tag: "max=65535", wantErr: ErrValidation},
+		{name: "Float within limits", value: 0.5, tag: "min=0,max=1"},
+		{name: "Duration above max", value: time.Minute, tag: "max=30s", wantErr: ErrValidation},
+		{name: "String length", value: "ab", tag: "min=3", wantErr: ErrValidation},
+		{name: "Slice length", value: []string{"a"}, tag: "min=1"},
+		{name: "Valid oneof", value: "info", tag: "oneof=debug info warn"},
+		{name: "Invalid oneof", value: "trace", tag: "oneof=debug info warn", wantErr: ErrValidation},
+		{name: "Oneof on slice items", value: []string{"a", "c"}, tag: "oneof=a b", wantErr: ErrValidation},
+		{name: "Matching regexp", value: "abc", tag: "regexp=^[a-z]{1,3}$"},
+		{name: "Not matching regexp", value: "abcd", tag: "regexp=^[a-z]{1,3}$", wantErr: ErrValidation},
+		{name: "Valid url", value: "https://example.com/path", tag: "url"},
+		{name: "Invalid url", value: "example.com", tag: "url", wantErr: ErrValidation},
+		{name: "Empty string", value: "", tag: "nonempty", wantErr: ErrValidation},
+		{name: "Empty map", value: map[string]string{}, tag: "nonempty", wantErr: ErrValidation},
+		{name: "Unknown rule", value: "value", tag: "unknown", wantErr: ErrInvalidValidationRule},
+		{name: "Invalid limit", value: 1, tag: "min=one", wantErr: ErrInvalidValidationRule},
+		{name: "Invalid regexp", value: "value", tag: "regexp=[", wantErr: ErrInvalidValidationRule},
+		{name: "Unset nonempty", value: "", tag: "nonempty", unset: true, wantErr: ErrValidation},
+		{name: "Unset below min", value: 0, tag: "min=1", unset: true, wantErr: ErrValidation},
+		{name: "Unset oneof", value: "", tag: "oneof=a b", unset: true},
+		{name: "Unset regexp", value: "", tag: "regexp=^[a-z]+$", unset: true},
+		{name: "Unset url", value: "", tag: "url", unset: true},
+		{name: "Unset unknown rule", value: "", tag: "unknown", unset: true, wantErr: ErrInvalidValidationRule},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			field := reflect.StructField{Name: "Field", Tag: reflect.StructTag(`validate:"` + testCase.tag + `"`)}
+
+			err := validateField(reflect.ValueOf(testCase.value), field, !testCase.unset)
+
+			if testCase.wantErr == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package gofig
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/darklam/gofig/interfaces"
+)
+
+// Change describes a reload of a watched config that changed at least one field
+type Change[T any] struct {
+	// The config before the reload
+	Old *T
+
+	// The config after the reload
+	New *T
+
+	// The fields whose values changed, sorted by path
+	Diff []FieldChange
+}
+
+// FieldChange describes the change of a single field of a watched config
+type FieldChange struct {
+	// The full prop path of the field (e.g. postgres.host)
+	Path string
+
+	// The value of the field before the change, nil if it had no value
+	Old interface{}
+
+	// The value of the field after the change, nil if it has no value anymore
+	New interface{}
+}
+
+// Watcher holds the current value of a watched config and notifies its subscribers when it changes
+type Watcher[T any] struct {
+	gofig      *Gofig
+	current    atomic.Pointer[T]
+	provenance Provenance
+
+	mu            sync.Mutex
+	subscribers   []func(Change[T])
+	errorHandlers []func(error)
+}
+
+// Watch populates a new config of type T and populates it again every time one of the registered providers
+// that implement interfaces.WatchableProvider signals a change, until the context is done.
+// Every reload builds a new config, so the configs returned by the watcher are never modified and are
+// safe to use concurrently. All providers must be registered before calling Watch
+func Watch[T any](ctx context.Context, gofig *Gofig) (*Watcher[T], error) {
+	watcher := &Watcher[T]{gofig: gofig}
+
+	cfg := new(T)
+
+	provenance, err := gofig.populate(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher.current.Store(cfg)
+	watcher.provenance = provenance
+
+	// Stop the providers that already started watching if one of them fails
+	watchCtx, cancel := context.WithCancel(ctx)
+
+	changes := make(chan error)
+	var wg sync.WaitGroup
+
+	for _, registered := range gofig.providers {
+		watchable, ok := registered.provider.(interfaces.WatchableProvider)
+		if !ok {
+			continue
+		}
+
+		name := registered.displayName()
+
+		providerChanges, err := watchable.Watch(watchCtx)
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("provider %s: %w", name, err)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for err := range providerChanges {
+				if err != nil {
+					err = fmt.Errorf("provider %s: %w", name, err)
+				}
+
+				select {
+				case changes <- err:
+				case <-watchCtx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		cancel()
+		close(changes)
+	}()
+
+	go func() {
+		for err := range changes {
+			if err != nil {
+				watcher.notifyError(err)
+				continue
+			}
+
+			watcher.reload(watchCtx)
+		}
+	}()
+
+	return watcher, nil
+}
+
+// Config returns the current config. It must not be modified, since it is shared with the other readers
+func (w *Watcher[T]) Config() *T {
+	return w.current.Load()
+}
+
+// Subscribe registers a function that is called after every reload that changed the config
+func (w *Watcher[T]) Subscribe(subscriber func(Change[T])) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.subscribers = append(w.subscribers, subscriber)
+}
+
+// OnError registers a function that is called when a provider fails to reload its values or the reloaded
+// config cannot be populated. In both cases the current config is kept
+func (w *Watcher[T]) OnError(handler func(error)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.errorHandlers = append(w.errorHandlers, handler)
+}
+
+func (w *Watcher[T]) reload(ctx context.Context) {
+	cfg := new(T)
+
+	provenance, err := w.gofig.populate(ctx, cfg)
+	if err != nil {
+		w.notifyError(err)
+		return
+	}
+
+	diff := diffProvenance(w.provenance, provenance)
+	if len(diff) == 0 {
+		return
+	}
+
+	old := w.current.Swap(cfg)
+	w.provenance = provenance
+
+	w.mu.Lock()
+	subscribers := append([]func(Change[T]){}, w.subscribers...)
+	w.mu.Unlock()
+
+	for _, subscriber := range subscribers {
+		subscriber(Change[T]{Old: old, New: cfg, Diff: diff})
+	}
+}
+
+func (w *Watcher[T]) notifyError(err error) {
+	w.mu.Lock()
+	handlers := append([]func(error){}, w.errorHandlers...)
+	w.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(err)
+	}
+}
+
+// diffProvenance returns the changes between the final values of two provenances sorted by path
+func diffProvenance(old Provenance, new Provenance) []FieldChange {
+	diff := make([]FieldChange, 0)
+
+	for path, current := range new {
+		previous, ok := old[path]
+		if !ok {
+			diff = append(diff, FieldChange{Path: path, New: current.Source.Value})
+		} else if !reflect.DeepEqual(previous.Source.Value, current.Source.Value) {
+			diff = append(diff, FieldChange{Path: path, Old: previous.Source.Value, New: current.Source.Value})
+		}
+	}
+
+	for path, previous := range old {
+		if _, ok := new[path]; !ok {
+			diff = append(diff, FieldChange{Path: path, Old: previous.Source.Value})
+		}
+	}
+
+	sort.Slice(diff, func(i, j int) bool {
+		return diff[i].Path < diff[j].Path
+	})
+
+	return diff
+}
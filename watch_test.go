@@ -0,0 +1,131 @@
+package gofig
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/darklam/gofig/mocks/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWatch_ReloadsOnChange(t *testing.T) {
+	// GIVEN
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var host atomic.Value
+	host.Store("db")
+
+	changes := make(chan error)
+
+	provider := interfaces.NewMockWatchableProvider(t)
+
+	provider.On("GetValue", []string{"postgres", "host"}).Return(func(fieldPath []string) (string, error) {
+		return host.Load().(string), nil
+	})
+	provider.On("GetValue", mock.Anything).Return("", nil)
+	provider.On("Watch", mock.Anything).Return((<-chan error)(changes), nil)
+
+	gofig := NewGofig()
+
+	gofig.RegisterProvider(provider)
+
+	type config struct {
+		Host string `prop:"postgres.host"`
+		Port int    `prop:"postgres.port" default:"5432"`
+	}
+
+	// WHEN
+	watcher, err := Watch[config](ctx, gofig)
+
+	// THEN
+	assert.Nil(t, err)
+	assert.Equal(t, "db", watcher.Config().Host)
+
+	received := make(chan Change[config], 1)
+	watcher.Subscribe(func(change Change[config]) {
+		received <- change
+	})
+
+	host.Store("db.internal")
+	changes <- nil
+
+	select {
+	case change := <-received:
+		assert.Equal(t, "db", change.Old.Host)
+		assert.Equal(t, "db.internal", change.New.Host)
+		assert.Equal(t, []FieldChange{{Path: "postgres.host", Old: "db", New: "db.internal"}}, change.Diff)
+		assert.Equal(t, "db.internal", watcher.Config().Host)
+	case <-time.After(time.Second):
+		t.Fatal("no change received")
+	}
+}
+
+func TestWatch_ReportsProviderErrors(t *testing.T) {
+	// GIVEN
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan error)
+
+	provider := interfaces.NewMockWatchableProvider(t)
+
+	provider.On("GetValue", mock.Anything).Return("value", nil)
+	provider.On("Watch", mock.Anything).Return((<-chan error)(changes), nil)
+
+	gofig := NewGofig()
+
+	gofig.RegisterProvider(provider)
+
+	type config struct {
+		Value string `prop:"value"`
+	}
+
+	watcher, err := Watch[config](ctx, gofig)
+	assert.Nil(t, err)
+
+	received := make(chan error, 1)
+	watcher.OnError(func(err error) {
+		received <- err
+	})
+
+	reloadErr := errors.New("something went wrong")
+
+	// WHEN
+	changes <- reloadErr
+
+	// THEN
+	select {
+	case err := <-received:
+		assert.ErrorIs(t, err, reloadErr)
+		assert.Equal(t, "value", watcher.Config().Value)
+	case <-time.After(time.Second):
+		t.Fatal("no error received")
+	}
+}
+
+func TestWatch_DiffProvenance(t *testing.T) {
+	old := Provenance{
+		"same":    {Source: Source{Provider: DefaultSource, Value: "a"}},
+		"changed": {Source: Source{Provider: DefaultSource, Value: "a"}},
+		"removed": {Source: Source{Provider: DefaultSource, Value: "a"}},
+	}
+
+	new := Provenance{
+		"same":    {Source: Source{Provider: DefaultSource, Value: "a"}},
+		"changed": {Source: Source{Provider: DefaultSource, Value: "b"}},
+		"added":   {Source: Source{Provider: DefaultSource, Value: []interface{}{"b"}}},
+	}
+
+	diff := diffProvenance(old, new)
+
+	assert.Equal(t, []FieldChange{
+		{Path: "added", New: []interface{}{"b"}},
+		{Path: "changed", Old: "a", New: "b"},
+		{Path: "removed", Old: "a"},
+	}, diff)
+}